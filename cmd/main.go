@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,8 +13,11 @@ import (
 	"sync"
 	_ "sync"
 	"syscall"
+	"text/tabwriter"
 	"time"
 	"toptal/internal/app/config"
+	"toptal/internal/app/notifier"
+	"toptal/internal/app/payment"
 	"toptal/internal/app/repository/pgrepo"
 	"toptal/internal/app/services"
 	"toptal/internal/app/transport/grpcserver"
@@ -22,6 +26,10 @@ import (
 	bookv1 "toptal/proto/v1/book"
 	cartv1 "toptal/proto/v1/cart"
 	categoryv1 "toptal/proto/v1/category"
+	inventoryv1 "toptal/proto/v1/inventory"
+	orderv1 "toptal/proto/v1/order"
+	subscriptionv1 "toptal/proto/v1/subscription"
+	wishlistv1 "toptal/proto/v1/wishlist"
 
 	"toptal/internal/pkg/pg"
 
@@ -41,6 +49,13 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reconcile-stock" {
+		if err := reconcileStock(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(0)
+	}
+
 	if err := run(); err != nil {
 		log.Fatal(err)
 	}
@@ -73,18 +88,41 @@ func run() error {
 	bookRepo := pgrepo.NewBookRepository(pgDB)
 	categoryRepo := pgrepo.NewCategoryRepository(pgDB)
 	cartRepo := pgrepo.NewCartRepository(pgDB)
+	orderRepo := pgrepo.NewOrderRepository(pgDB)
+	idempotencyRepo := pgrepo.NewIdempotencyRepository(pgDB)
+	wishlistRepo := pgrepo.NewWishlistRepository(pgDB)
+	subscriptionRepo := pgrepo.NewSubscriptionRepository(pgDB)
+	inventoryRepo := pgrepo.NewInventoryRepository(pgDB)
 
 	userService := services.NewUserService(userRepo)
 	authService := services.NewAuthService(userRepo)
 	bookService := services.NewBookService(bookRepo)
 	categoryService := services.NewCategoryService(categoryRepo)
-	cartService := services.NewCartService(cartRepo)
+	// there is no real payment gateway yet, every payment is approved
+	payments := payment.NewFakeProvider()
+
+	cartService := services.NewCartService(cartRepo, orderRepo, payments, cfg.PaymentTimeout)
+	orderService := services.NewOrderService(orderRepo, payments)
+	idempotencyService := services.NewIdempotencyService(idempotencyRepo, cfg.IdempotencyTTL, cfg.IdempotencyLease)
+	wishlistService := services.NewWishlistService(wishlistRepo, cartRepo)
+
+	stockNotifier, closeNotifier, err := newNotifier(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create notifier: %w", err)
+	}
+	defer func() {
+		if err := closeNotifier(); err != nil {
+			log.Printf("failed to close notifier: %v", err)
+		}
+	}()
+	notificationService := services.NewNotificationService(subscriptionRepo, stockNotifier, cfg.NotifyTimeout)
+	inventoryService := services.NewInventoryService(inventoryRepo)
 
 	// create http server
-	httpServer := httpserver.NewHttpServer(userService, authService, bookService, cartService, categoryService)
+	httpServer := httpserver.NewHttpServer(userService, authService, bookService, cartService, categoryService, orderService, idempotencyService, wishlistService, notificationService, inventoryService)
 
 	// create grpc server
-	grpcServer := grpcserver.NewGrpcServer(userService, authService, bookService, cartService, categoryService)
+	grpcServer := grpcserver.NewGrpcServer(userService, authService, bookService, cartService, categoryService, orderService, idempotencyService, wishlistService, notificationService, inventoryService)
 
 	// create router
 	router := chi.NewRouter()
@@ -106,12 +144,22 @@ func run() error {
 		r.Post("/signin", httpServer.SignIn)
 
 		// Books
-		r.Get("/books", httpServer.GetBooks)
+		r.With(httpServer.IdentifyUser).Get("/books", httpServer.GetBooks)
+		r.With(httpServer.IdentifyUser).Get("/books/facets", httpServer.GetBookFacets)
+		r.Get("/books/suggest", httpServer.SuggestBooks)
 		router.Get("/book/{book_id}", httpServer.GetBook)
 
 		// Categories
 		r.Get("/categories", httpServer.GetCategories)
+		r.Get("/categories/tree", httpServer.GetCategoryTree)
 		r.Get("/category/{category_id}", httpServer.GetCategory)
+		r.Get("/category/by-slug/{slug}", httpServer.GetCategoryBySlug)
+
+		// Guest cart
+		r.Get("/guest/cart", httpServer.GetGuestCart)
+		r.Put("/guest/cart/items/{book_id}", httpServer.AddGuestCartItem)
+		r.Delete("/guest/cart/items/{book_id}", httpServer.RemoveGuestCartItem)
+		r.Delete("/guest/cart", httpServer.ClearGuestCart)
 	})
 
 	// Protected routes (auth needed)
@@ -119,8 +167,36 @@ func run() error {
 		r.Use(httpServer.CheckAuthorizedUser)
 
 		//Cart
-		r.Post("/cart", httpServer.UpdateCart)
-		r.Post("/checkout", httpServer.Checkout)
+		r.Get("/cart", httpServer.GetCart)
+		r.Group(func(r chi.Router) {
+			r.Use(httpServer.Idempotent)
+
+			r.Post("/cart", httpServer.UpdateCart)
+			r.Put("/cart/items/{book_id}", httpServer.AddCartItem)
+			r.Delete("/cart/items/{book_id}", httpServer.RemoveCartItem)
+			r.Delete("/cart", httpServer.ClearCart)
+			r.Post("/checkout", httpServer.Checkout)
+		})
+
+		// Orders
+		r.Get("/orders", httpServer.GetOrders)
+		r.Get("/orders/{order_id}", httpServer.GetOrder)
+		r.Post("/orders/{order_id}/cancel", httpServer.CancelOrder)
+
+		// Wishlists
+		r.Get("/wishlists", httpServer.GetWishlists)
+		r.Post("/wishlists", httpServer.CreateWishlist)
+		r.Get("/wishlists/{wishlist_id}", httpServer.GetWishlist)
+		r.Patch("/wishlists/{wishlist_id}", httpServer.RenameWishlist)
+		r.Delete("/wishlists/{wishlist_id}", httpServer.DeleteWishlist)
+		r.Put("/wishlists/{wishlist_id}/items/{book_id}", httpServer.AddWishlistItem)
+		r.Delete("/wishlists/{wishlist_id}/items/{book_id}", httpServer.RemoveWishlistItem)
+		r.Post("/wishlists/{wishlist_id}/items/{book_id}/move-to-cart", httpServer.MoveWishlistItemToCart)
+
+		// Back in stock subscriptions
+		r.Get("/subscriptions", httpServer.GetSubscriptions)
+		r.Put("/subscriptions/{book_id}", httpServer.Subscribe)
+		r.Delete("/subscriptions/{book_id}", httpServer.Unsubscribe)
 	})
 
 	// Admin routes (admin auth needed)
@@ -131,11 +207,18 @@ func run() error {
 		r.Post("/book", httpServer.CreateBook)
 		r.Patch("/book/{book_id}", httpServer.UpdateBook)
 		r.Delete("/book/{book_id}", httpServer.DeleteBook)
+		r.Post("/book/{book_id}/restock", httpServer.RestockBook)
+		r.Get("/book/{book_id}/inventory", httpServer.GetBookInventory)
+		r.Get("/inventory/reconciliation", httpServer.GetStockReconciliation)
+		r.Post("/inventory/reconciliation", httpServer.FixStockReconciliation)
 
 		// Categories
 		r.Post("/category", httpServer.CreateCategory)
 		r.Patch("/category/{category_id}", httpServer.UpdateCategory)
 		r.Delete("/category/{category_id}", httpServer.DeleteCategory)
+
+		// Orders
+		r.Patch("/orders/{order_id}/status", httpServer.UpdateOrderStatus)
 	})
 
 	err = addGrpcEndpoints(router, cfg.GRPCAddr, httpServer)
@@ -143,7 +226,7 @@ func run() error {
 		return fmt.Errorf("failed to add gRPC gateway routes: %w", err)
 	}
 
-	// Clean expired carts every minute
+	// Clean expired carts and idempotency keys every minute
 	ctx, cleanupCancel := context.WithCancel(context.Background())
 	cleanupFinished := make(chan struct{})
 	go func() {
@@ -154,10 +237,14 @@ func run() error {
 			select {
 			case <-ticker.C:
 				log.Println("Cleaning expired carts")
-				err := cartRepo.CleanExpiredCarts(ctx, 30*time.Minute)
+				err := cartRepo.CleanExpiredCarts(ctx)
 				if err != nil {
 					log.Printf("cartRepo.CleanExpiredCarts failed: %v", err)
 				}
+				err = idempotencyRepo.DeleteExpiredKeys(ctx, cfg.IdempotencyTTL)
+				if err != nil {
+					log.Printf("idempotencyRepo.DeleteExpiredKeys failed: %v", err)
+				}
 			case <-ctx.Done():
 				log.Println("Cart cleanup goroutine stopped")
 				return
@@ -165,6 +252,29 @@ func run() error {
 		}
 	}()
 
+	// Send back in stock notifications every minute, a slow mail server doesn't hold up the cleanup
+	dispatchFinished := make(chan struct{})
+	go func() {
+		defer close(dispatchFinished)
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				sent, err := notificationService.DispatchNotifications(ctx)
+				if err != nil {
+					log.Printf("notificationService.DispatchNotifications failed: %v", err)
+				}
+				if sent > 0 {
+					log.Printf("Sent %d back in stock notifications", sent)
+				}
+			case <-ctx.Done():
+				log.Println("Notification dispatch goroutine stopped")
+				return
+			}
+		}
+	}()
+
 	srv := &http.Server{
 		Addr:    cfg.HTTPAddr,
 		Handler: router,
@@ -178,7 +288,7 @@ func run() error {
 		signal.Notify(sigint, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 		<-sigint
 
-		cleanupCancel() // stop cart cleanup goroutine
+		cleanupCancel() // stop cart cleanup and notification dispatch goroutines
 
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer shutdownCancel()
@@ -213,6 +323,7 @@ func run() error {
 	//Wait for goroutines to finish
 	<-serverStopped
 	<-cleanupFinished
+	<-dispatchFinished
 	wg.Wait()
 
 	log.Printf("Have a nice day!")
@@ -232,6 +343,8 @@ func addGrpcEndpoints(router *chi.Mux, addr string, httpServer *httpserver.HttpS
 				return key, true
 			case "user-id", "user-email", "user-admin":
 				return key, true
+			case "idempotency-key":
+				return key, true
 			default:
 				return runtime.DefaultHeaderMatcher(key)
 			}
@@ -262,17 +375,75 @@ func addGrpcEndpoints(router *chi.Mux, addr string, httpServer *httpserver.HttpS
 		return fmt.Errorf("failed to register cart service handler: %w", err)
 	}
 
+	err = cartv1.RegisterGuestCartServiceHandlerFromEndpoint(ctx, gwMux, addr, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register guest cart service handler: %w", err)
+	}
+
+	err = orderv1.RegisterOrderServiceHandlerFromEndpoint(ctx, gwMux, addr, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register order service handler: %w", err)
+	}
+
+	err = wishlistv1.RegisterWishlistServiceHandlerFromEndpoint(ctx, gwMux, addr, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register wishlist service handler: %w", err)
+	}
+
+	err = inventoryv1.RegisterInventoryServiceHandlerFromEndpoint(ctx, gwMux, addr, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register inventory service handler: %w", err)
+	}
+
+	err = subscriptionv1.RegisterSubscriptionServiceHandlerFromEndpoint(ctx, gwMux, addr, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register subscription service handler: %w", err)
+	}
+
 	gwRouter := chi.NewRouter()
 	gwRouter.Mount("/", gwMux)
 	router.Mount("/v1", gwRouter)
 
+	// Public routes that tell signed in users apart
+	router.Group(func(r chi.Router) {
+		r.Use(httpServer.IdentifyUser)
+
+		// Books
+		r.Get("/v1/books", gwMux.ServeHTTP)
+		r.Get("/v1/books/facets", gwMux.ServeHTTP)
+	})
+
 	// Protected routes (auth needed)
 	router.Group(func(r chi.Router) {
 		r.Use(httpServer.CheckAuthorizedUser)
 
 		//Cart
+		r.Get("/v1/cart", gwMux.ServeHTTP)
 		r.Post("/v1/cart", gwMux.ServeHTTP)
+		r.Put("/v1/cart/items/{book_id}", gwMux.ServeHTTP)
+		r.Delete("/v1/cart/items/{book_id}", gwMux.ServeHTTP)
+		r.Delete("/v1/cart", gwMux.ServeHTTP)
 		r.Post("/v1/checkout", gwMux.ServeHTTP)
+
+		// Orders
+		r.Get("/v1/orders", gwMux.ServeHTTP)
+		r.Get("/v1/orders/{order_id}", gwMux.ServeHTTP)
+		r.Post("/v1/orders/{order_id}/cancel", gwMux.ServeHTTP)
+
+		// Wishlists
+		r.Get("/v1/wishlists", gwMux.ServeHTTP)
+		r.Post("/v1/wishlists", gwMux.ServeHTTP)
+		r.Get("/v1/wishlists/{wishlist_id}", gwMux.ServeHTTP)
+		r.Patch("/v1/wishlists/{wishlist_id}", gwMux.ServeHTTP)
+		r.Delete("/v1/wishlists/{wishlist_id}", gwMux.ServeHTTP)
+		r.Put("/v1/wishlists/{wishlist_id}/items/{book_id}", gwMux.ServeHTTP)
+		r.Delete("/v1/wishlists/{wishlist_id}/items/{book_id}", gwMux.ServeHTTP)
+		r.Post("/v1/wishlists/{wishlist_id}/items/{book_id}/move-to-cart", gwMux.ServeHTTP)
+
+		// Back in stock subscriptions
+		r.Get("/v1/subscriptions", gwMux.ServeHTTP)
+		r.Put("/v1/subscriptions/{book_id}", gwMux.ServeHTTP)
+		r.Delete("/v1/subscriptions/{book_id}", gwMux.ServeHTTP)
 	})
 
 	// Admin routes (admin auth needed)
@@ -283,15 +454,80 @@ func addGrpcEndpoints(router *chi.Mux, addr string, httpServer *httpserver.HttpS
 		r.Post("/v1/book", gwMux.ServeHTTP)
 		r.Patch("/v1/book/{book_id}", gwMux.ServeHTTP)
 		r.Delete("/v1/book/{book_id}", gwMux.ServeHTTP)
+		r.Post("/v1/book/{book_id}/restock", gwMux.ServeHTTP)
+		r.Get("/v1/book/{book_id}/inventory", gwMux.ServeHTTP)
+		r.Get("/v1/inventory/reconciliation", gwMux.ServeHTTP)
+		r.Post("/v1/inventory/reconciliation", gwMux.ServeHTTP)
 
 		// Categories
 		r.Post("/v1/category", gwMux.ServeHTTP)
 		r.Patch("/v1/category/{category_id}", gwMux.ServeHTTP)
 		r.Delete("/v1/category/{category_id}", gwMux.ServeHTTP)
+
+		// Orders
+		r.Patch("/v1/orders/{order_id}/status", gwMux.ServeHTTP)
 	})
 	return nil
 }
 
+// reconcileStock reports the books whose stock doesn't match the one expected from restocks, active cart reservations
+// and sales, with --fix the stocks are corrected. It fails when mismatches are left.
+func reconcileStock(args []string) error {
+	flags := flag.NewFlagSet("reconcile-stock", flag.ExitOnError)
+	fix := flags.Bool("fix", false, "correct the stocks that don't match by adjustments in the inventory ledger")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := config.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+	defer pgDB.Close()
+
+	reconciliations, err := pgrepo.NewInventoryRepository(pgDB).ReconcileStocks(context.Background(), *fix, 0)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BOOK\tTITLE\tSTOCK\tEXPECTED\tRESTOCKED\tRESERVED\tSOLD\tSTATUS")
+	left := 0
+	for _, reconciliation := range reconciliations {
+		if reconciliation.Consistent() && !reconciliation.Fixed() {
+			continue
+		}
+
+		status := "mismatch"
+		switch {
+		case reconciliation.Fixed():
+			status = "fixed"
+		case *fix:
+			status = "not fixed, expected stock is negative"
+			left++
+		default:
+			left++
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n", reconciliation.BookID(), reconciliation.Title(), reconciliation.Stock(),
+			reconciliation.Expected(), reconciliation.Restocked(), reconciliation.Reserved(), reconciliation.Sold(), status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("%d books checked\n", len(reconciliations))
+	if left > 0 {
+		return fmt.Errorf("%d stocks don't match", left)
+	}
+
+	return nil
+}
+
 // migratePgData runs Postgres migrations
 func migratePgData(path string, dsn string) error {
 	if dsn == "" {
@@ -321,3 +557,32 @@ func migratePgData(path string, dsn string) error {
 
 	return nil
 }
+
+// newNotifier creates the back in stock notifier picked by cfg.Notifier, the returned func releases it
+func newNotifier(cfg config.Config) (services.Notifier, func() error, error) {
+	noop := func() error { return nil }
+	switch cfg.Notifier {
+	case "smtp":
+		smtpNotifier, err := notifier.NewSMTPNotifier(notifier.SMTPConfig{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return smtpNotifier, noop, nil
+	case "log":
+		if cfg.NotifyLogPath == "" {
+			return notifier.NewLogNotifier(os.Stdout), noop, nil
+		}
+		file, err := os.OpenFile(cfg.NotifyLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open notification log: %w", err)
+		}
+		return notifier.NewLogNotifier(file), file.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown notifier %q, expected log or smtp", cfg.Notifier)
+	}
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/transport/models"
@@ -11,31 +12,95 @@ import (
 
 func ToResponseBook(book domain.Book) models.BookResponse {
 	return models.BookResponse{
-		ID:         book.ID(),
-		Title:      book.Title(),
-		Year:       book.Year(),
-		Author:     book.Author(),
-		Price:      book.Price(),
-		Stock:      book.Stock(),
-		CategoryID: book.CategoryID(),
+		ID:          book.ID(),
+		Title:       book.Title(),
+		Year:        book.Year(),
+		Author:      book.Author(),
+		Price:       book.Price(),
+		Stock:       book.Stock(),
+		CategoryID:  book.CategoryID(),
+		CategoryIDs: book.CategoryIDs(),
+		Archived:    book.Archived(),
 	}
 }
 
+func ToResponseBookSuggestion(suggestion domain.BookSuggestion) models.BookSuggestionResponse {
+	return models.BookSuggestionResponse{
+		Text:  suggestion.Text(),
+		Kind:  string(suggestion.Kind()),
+		Score: suggestion.Score(),
+	}
+}
+
+func ToResponseBookFacets(facets domain.BookFacets) models.BookFacetsResponse {
+	response := models.BookFacetsResponse{
+		Categories: make([]models.CategoryFacetResponse, 0, len(facets.Categories())),
+		Decades:    make([]models.DecadeFacetResponse, 0, len(facets.Decades())),
+		Prices:     make([]models.PriceFacetResponse, 0, len(facets.Prices())),
+	}
+	for _, category := range facets.Categories() {
+		response.Categories = append(response.Categories, models.CategoryFacetResponse{
+			CategoryID: category.CategoryID(),
+			Name:       category.Name(),
+			Count:      category.Count(),
+		})
+	}
+	for _, decade := range facets.Decades() {
+		response.Decades = append(response.Decades, models.DecadeFacetResponse{
+			Decade: decade.Decade(),
+			Count:  decade.Count(),
+		})
+	}
+	for _, price := range facets.Prices() {
+		response.Prices = append(response.Prices, models.PriceFacetResponse{
+			MinPrice: price.MinPrice(),
+			MaxPrice: price.MaxPrice(),
+			Count:    price.Count(),
+		})
+	}
+
+	return response
+}
+
 func ToResponseCategory(category domain.Category) models.CategoryResponse {
-	return models.CategoryResponse{
-		ID:   category.ID(),
-		Name: category.Name(),
+	response := models.CategoryResponse{
+		ID:           category.ID(),
+		Name:         category.Name(),
+		Slug:         category.Slug(),
+		Description:  category.Description(),
+		DisplayOrder: category.DisplayOrder(),
+		BookCount:    category.BookCount(),
 	}
+	if parentID := category.ParentID(); parentID != 0 {
+		response.ParentID = &parentID
+	}
+
+	return response
+}
+
+func ToResponseCategoryTree(nodes []domain.CategoryNode) []models.CategoryTreeResponse {
+	response := make([]models.CategoryTreeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		response = append(response, models.CategoryTreeResponse{
+			ID:       node.Category().ID(),
+			Name:     node.Category().Name(),
+			Slug:     node.Category().Slug(),
+			Children: ToResponseCategoryTree(node.Children()),
+		})
+	}
+
+	return response
 }
 
 func ToDomainBook(bookRequest models.BookRequest) (domain.Book, error) {
 	return domain.NewBook(domain.NewBookData{
-		Title:      bookRequest.Title,
-		Year:       bookRequest.Year,
-		Author:     bookRequest.Author,
-		Price:      bookRequest.Price,
-		Stock:      bookRequest.Stock,
-		CategoryID: bookRequest.CategoryID,
+		Title:       bookRequest.Title,
+		Year:        bookRequest.Year,
+		Author:      bookRequest.Author,
+		Price:       bookRequest.Price,
+		Stock:       bookRequest.Stock,
+		CategoryID:  bookRequest.CategoryID,
+		CategoryIDs: bookRequest.CategoryIDs,
 	})
 }
 
@@ -47,9 +112,21 @@ func ToDomainUser(username, password string) (domain.User, error) {
 }
 
 func ToDomainCart(userID int, cartRequest models.CartRequest) (domain.Cart, error) {
+	var quantities map[int]int
+	if len(cartRequest.Items) > 0 {
+		quantities = make(map[int]int, len(cartRequest.Items))
+		for _, item := range cartRequest.Items {
+			if _, exists := quantities[item.BookID]; exists {
+				return domain.Cart{}, fmt.Errorf("%w: book %d is listed twice", domain.ErrInvalidBookIDs, item.BookID)
+			}
+			quantities[item.BookID] = item.Quantity
+		}
+	}
+
 	return domain.NewCart(domain.NewCartData{
-		UserID:  userID,
-		BookIDs: cartRequest.BookIDs,
+		UserID:     userID,
+		BookIDs:    cartRequest.BookIDs,
+		Quantities: quantities,
 	})
 }
 
@@ -59,6 +136,140 @@ func ToResponseCart(cart domain.Cart) models.CartResponse {
 	}
 }
 
+func ToResponseCartItems(items []domain.CartItem) models.CartResponse {
+	response := models.CartResponse{
+		BookIDs: make([]int, 0, len(items)),
+		Items:   make([]models.CartItemResponse, 0, len(items)),
+	}
+	for _, item := range items {
+		response.BookIDs = append(response.BookIDs, item.BookID())
+		response.Items = append(response.Items, models.CartItemResponse{
+			BookID:     item.BookID(),
+			Title:      item.Title(),
+			Price:      item.Price(),
+			Quantity:   item.Quantity(),
+			ReservedAt: item.ReservedAt(),
+			ExpiresAt:  item.ExpiresAt(),
+		})
+	}
+
+	return response
+}
+
+func ToResponseOrder(order domain.Order) models.OrderResponse {
+	items := make([]models.OrderItemResponse, 0, len(order.Items()))
+	for _, item := range order.Items() {
+		items = append(items, models.OrderItemResponse{
+			BookID:   item.BookID(),
+			Title:    item.Title(),
+			Price:    item.Price(),
+			Quantity: item.Quantity(),
+		})
+	}
+
+	var history []models.OrderTransitionResponse
+	for _, transition := range order.History() {
+		history = append(history, models.OrderTransitionResponse{
+			From:      string(transition.From()),
+			To:        string(transition.To()),
+			ActorID:   transition.ActorID(),
+			CreatedAt: transition.CreatedAt(),
+		})
+	}
+
+	response := models.OrderResponse{
+		ID:        order.ID(),
+		UserID:    order.UserID(),
+		Items:     items,
+		Total:     order.Total(),
+		Status:    string(order.Status()),
+		History:   history,
+		CreatedAt: order.CreatedAt(),
+		UpdatedAt: order.UpdatedAt(),
+	}
+	if refundedAt := order.RefundedAt(); !refundedAt.IsZero() {
+		response.RefundedAt = &refundedAt
+	}
+
+	return response
+}
+
+func ToResponseWishlist(wishlist domain.Wishlist) models.WishlistResponse {
+	items := make([]models.WishlistItemResponse, 0, len(wishlist.Items()))
+	for _, item := range wishlist.Items() {
+		items = append(items, models.WishlistItemResponse{
+			BookID:  item.BookID(),
+			Title:   item.Title(),
+			Price:   item.Price(),
+			InStock: item.InStock(),
+			AddedAt: item.AddedAt(),
+		})
+	}
+
+	return models.WishlistResponse{
+		ID:        wishlist.ID(),
+		Name:      wishlist.Name(),
+		Items:     items,
+		CreatedAt: wishlist.CreatedAt(),
+		UpdatedAt: wishlist.UpdatedAt(),
+	}
+}
+
+func ToResponseSubscription(subscription domain.BookSubscription) models.SubscriptionResponse {
+	return models.SubscriptionResponse{
+		BookID:    subscription.BookID(),
+		CreatedAt: subscription.CreatedAt(),
+	}
+}
+
+func ToResponseInventory(inventory domain.Inventory) models.InventoryResponse {
+	movements := make([]models.InventoryMovementResponse, 0, len(inventory.Movements()))
+	for _, movement := range inventory.Movements() {
+		movements = append(movements, models.InventoryMovementResponse{
+			ID:        movement.ID(),
+			Kind:      string(movement.Kind()),
+			Quantity:  movement.Quantity(),
+			Reason:    movement.Reason(),
+			ActorID:   movement.ActorID(),
+			CreatedAt: movement.CreatedAt(),
+		})
+	}
+
+	return models.InventoryResponse{
+		BookID:      inventory.BookID(),
+		Stock:       inventory.Stock(),
+		LedgerStock: inventory.LedgerStock(),
+		Consistent:  inventory.Consistent(),
+		Movements:   movements,
+	}
+}
+
+// ToResponseStockReconciliation reports the books whose stock didn't match the expected one, including the fixed ones
+func ToResponseStockReconciliation(reconciliations []domain.StockReconciliation) models.StockReconciliationReportResponse {
+	mismatches := make([]models.StockReconciliationResponse, 0)
+	for _, reconciliation := range reconciliations {
+		if reconciliation.Consistent() && !reconciliation.Fixed() {
+			continue
+		}
+		mismatches = append(mismatches, models.StockReconciliationResponse{
+			BookID:     reconciliation.BookID(),
+			Title:      reconciliation.Title(),
+			Stock:      reconciliation.Stock(),
+			Expected:   reconciliation.Expected(),
+			Difference: reconciliation.Difference(),
+			Restocked:  reconciliation.Restocked(),
+			Reserved:   reconciliation.Reserved(),
+			Sold:       reconciliation.Sold(),
+			Fixed:      reconciliation.Fixed(),
+		})
+	}
+
+	return models.StockReconciliationReportResponse{
+		Checked:    len(reconciliations),
+		Mismatches: mismatches,
+	}
+}
+
 func GetUserFromContext(ctx context.Context) (domain.User, error) {
 	contextUser := ctx.Value(ContextUserKey)
 	if contextUser == nil {
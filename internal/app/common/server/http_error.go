@@ -25,6 +25,14 @@ func NotFound(slug string, err error, w http.ResponseWriter, r *http.Request) {
 	httpRespondWithError(err, slug, w, r, "Not found", http.StatusBadRequest)
 }
 
+func GatewayTimeout(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Gateway timeout", http.StatusGatewayTimeout)
+}
+
+func Conflict(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, w, r, "Conflict", http.StatusConflict)
+}
+
 func RespondWithError(err error, w http.ResponseWriter, r *http.Request) {
 	var slugError slugerrors.SlugError
 	if !errors.As(err, &slugError) {
@@ -39,6 +47,10 @@ func RespondWithError(err error, w http.ResponseWriter, r *http.Request) {
 		BadRequest(slugError.Slug(), slugError, w, r)
 	case slugerrors.ErrorTypeNotFound:
 		NotFound(slugError.Slug(), slugError, w, r)
+	case slugerrors.ErrorTypeTimeout:
+		GatewayTimeout(slugError.Slug(), slugError, w, r)
+	case slugerrors.ErrorTypeConflict:
+		Conflict(slugError.Slug(), slugError, w, r)
 	default:
 		InternalError(slugError.Slug(), slugError, w, r)
 	}
@@ -9,6 +9,8 @@ var (
 	ErrorTypeAuthorization = ErrorType{"authorization"}
 	ErrorTypeBadRequest    = ErrorType{"bad-request"}
 	ErrorTypeNotFound      = ErrorType{"not-found"}
+	ErrorTypeTimeout       = ErrorType{"timeout"}
+	ErrorTypeConflict      = ErrorType{"conflict"}
 )
 
 type SlugError struct {
@@ -60,3 +62,19 @@ func NewNotFoundError(error string, slug string) SlugError {
 		errorType: ErrorTypeNotFound,
 	}
 }
+
+func NewTimeoutError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeTimeout,
+	}
+}
+
+func NewConflictError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeConflict,
+	}
+}
@@ -1,14 +1,27 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 type Config struct {
-	GRPCAddr       string `envconfig:"GRPC_ADDR" required:"true"`
-	HTTPAddr       string `envconfig:"HTTP_ADDR" required:"true"`
-	DSN            string `envconfig:"DSN"  required:"true"`
-	MigrationsPath string `envconfig:"MIGRATIONS_PATH" required:"true"`
+	GRPCAddr         string        `envconfig:"GRPC_ADDR" required:"true"`
+	HTTPAddr         string        `envconfig:"HTTP_ADDR" required:"true"`
+	DSN              string        `envconfig:"DSN"  required:"true"`
+	MigrationsPath   string        `envconfig:"MIGRATIONS_PATH" required:"true"`
+	PaymentTimeout   time.Duration `envconfig:"PAYMENT_TIMEOUT" default:"10s"`
+	IdempotencyTTL   time.Duration `envconfig:"IDEMPOTENCY_TTL" default:"24h"`
+	IdempotencyLease time.Duration `envconfig:"IDEMPOTENCY_LEASE" default:"2m"`
+	// Notifier is log or smtp, the log notifier writes to NotifyLogPath or to stdout
+	Notifier      string        `envconfig:"NOTIFIER" default:"log"`
+	NotifyLogPath string        `envconfig:"NOTIFY_LOG_PATH"`
+	NotifyTimeout time.Duration `envconfig:"NOTIFY_TIMEOUT" default:"30s"`
+	SMTPAddr      string        `envconfig:"SMTP_ADDR"`
+	SMTPFrom      string        `envconfig:"SMTP_FROM"`
+	SMTPUsername  string        `envconfig:"SMTP_USERNAME"`
+	SMTPPassword  string        `envconfig:"SMTP_PASSWORD"`
 }
 
 // Read reads config from environment using envconfig.
@@ -1,16 +1,23 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"time"
+)
 
 // Book is a domain book.
 type Book struct {
-	id         int
-	title      string
-	year       int
-	author     string
-	price      int
-	stock      int
-	categoryID int
+	id     int
+	title  string
+	year   int
+	author string
+	price  int
+	stock  int
+	// categoryID is the primary category, it's always one of the categoryIDs
+	categoryID  int
+	categoryIDs []int
+	archivedAt  time.Time
 }
 
 type NewBookData struct {
@@ -21,6 +28,10 @@ type NewBookData struct {
 	Price      int
 	Stock      int
 	CategoryID int
+	// CategoryIDs are all the categories of the book, the primary CategoryID is added when missing
+	CategoryIDs []int
+	// ArchivedAt is set when the category of the book was deleted, an archived book may have no category left
+	ArchivedAt time.Time
 }
 
 func NewBook(data NewBookData) (Book, error) {
@@ -28,16 +39,28 @@ func NewBook(data NewBookData) (Book, error) {
 		return Book{}, fmt.Errorf("faild book data validation: %w", err)
 	}
 	return Book{
-		id:         data.ID,
-		title:      data.Title,
-		year:       data.Year,
-		author:     data.Author,
-		price:      data.Price,
-		stock:      data.Stock,
-		categoryID: data.CategoryID,
+		id:          data.ID,
+		title:       data.Title,
+		year:        data.Year,
+		author:      data.Author,
+		price:       data.Price,
+		stock:       data.Stock,
+		categoryID:  data.CategoryID,
+		categoryIDs: bookCategoryIDs(data.CategoryID, data.CategoryIDs),
+		archivedAt:  data.ArchivedAt,
 	}, nil
 }
 
+// bookCategoryIDs returns the sorted set of the categories including the primary one
+func bookCategoryIDs(primaryID int, categoryIDs []int) []int {
+	ids := slices.Clone(categoryIDs)
+	if primaryID != 0 {
+		ids = append(ids, primaryID)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
 func validateBookData(data NewBookData) error {
 	if data.Title == "" {
 		return fmt.Errorf("%w: title", ErrRequired)
@@ -54,9 +77,14 @@ func validateBookData(data NewBookData) error {
 	if data.Stock < 0 {
 		return fmt.Errorf("%w: stock", ErrNegative)
 	}
-	if data.CategoryID == 0 {
+	if data.CategoryID == 0 && data.ArchivedAt.IsZero() {
 		return fmt.Errorf("%w: category_id", ErrRequired)
 	}
+	for _, categoryID := range data.CategoryIDs {
+		if categoryID <= 0 {
+			return fmt.Errorf("%w: category_ids", ErrNegative)
+		}
+	}
 	return nil
 }
 
@@ -87,3 +115,18 @@ func (b Book) Stock() int {
 func (b Book) CategoryID() int {
 	return b.categoryID
 }
+
+// CategoryIDs returns the sorted IDs of all the categories of the book, the primary one included
+func (b Book) CategoryIDs() []int {
+	return slices.Clone(b.categoryIDs)
+}
+
+// ArchivedAt returns when the book was archived, zero for the books on sale.
+func (b Book) ArchivedAt() time.Time {
+	return b.archivedAt
+}
+
+// Archived reports whether the book was archived, it's hidden from the listings and can't be reserved anymore.
+func (b Book) Archived() bool {
+	return !b.archivedAt.IsZero()
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -262,6 +263,70 @@ func TestNewBook_ValidationOrder_ReturnsFirstError(t *testing.T) {
 	assert.Equal(t, Book{}, book)
 }
 
+// Test the categories of the book always include the primary one
+func TestNewBook_CategoryIDs_IncludePrimary(t *testing.T) {
+	// Arrange
+	bookData := NewBookData{
+		Title:       "Dune",
+		Author:      "Frank Herbert",
+		Year:        1965,
+		Price:       1200,
+		CategoryID:  4,
+		CategoryIDs: []int{7, 2, 7},
+	}
+
+	// Act
+	book, err := NewBook(bookData)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 4, book.CategoryID())
+	assert.Equal(t, []int{2, 4, 7}, book.CategoryIDs())
+}
+
+// Test business rule: the extra categories must be valid IDs
+func TestNewBook_InvalidCategoryIDs_ReturnsNegativeError(t *testing.T) {
+	// Arrange
+	bookData := NewBookData{
+		Title:       "Dune",
+		Author:      "Frank Herbert",
+		Year:        1965,
+		Price:       1200,
+		CategoryID:  4,
+		CategoryIDs: []int{0},
+	}
+
+	// Act
+	book, err := NewBook(bookData)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNegative)
+	assert.Contains(t, err.Error(), "category_ids")
+	assert.Equal(t, Book{}, book)
+}
+
+// Test archived books may have lost their category
+func TestNewBook_ArchivedWithoutCategory_Success(t *testing.T) {
+	// Arrange
+	bookData := NewBookData{
+		Title:      "Dune",
+		Author:     "Frank Herbert",
+		Year:       1965,
+		Price:      1200,
+		ArchivedAt: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	book, err := NewBook(bookData)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, book.Archived())
+	assert.Zero(t, book.CategoryID())
+	assert.Empty(t, book.CategoryIDs())
+}
+
 // Test correct work of all getters
 func TestBook_Getters_ReturnCorrectValues(t *testing.T) {
 	// Arrange
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// priceBucketBounds split the prices into the buckets of the price facet, the last bucket has no upper bound
+var priceBucketBounds = []int{500, 1000, 2000, 5000}
+
+// PriceBucketBounds returns the lower bounds of the price buckets after the first one that starts at 0.
+func PriceBucketBounds() []int {
+	return slices.Clone(priceBucketBounds)
+}
+
+// PriceBucket returns the inclusive bounds of the price bucket at the index, max is zero for the last open bucket.
+func PriceBucket(index int) (minPrice, maxPrice int, err error) {
+	if index < 0 || index > len(priceBucketBounds) {
+		return 0, 0, fmt.Errorf("%w: price bucket %d", ErrNegative, index)
+	}
+	if index > 0 {
+		minPrice = priceBucketBounds[index-1]
+	}
+	if index < len(priceBucketBounds) {
+		maxPrice = priceBucketBounds[index] - 1
+	}
+	return minPrice, maxPrice, nil
+}
+
+// CategoryFacet is the number of listed books in a category.
+type CategoryFacet struct {
+	categoryID int
+	name       string
+	count      int
+}
+
+// DecadeFacet is the number of listed books published in a decade.
+type DecadeFacet struct {
+	decade int
+	count  int
+}
+
+// PriceFacet is the number of listed books in a price bucket.
+type PriceFacet struct {
+	minPrice int
+	maxPrice int
+	count    int
+}
+
+// BookFacets are the counts of the books listed by a filter per category, decade and price bucket.
+type BookFacets struct {
+	categories []CategoryFacet
+	decades    []DecadeFacet
+	prices     []PriceFacet
+}
+
+type NewBookFacetsData struct {
+	Categories []CategoryFacetData
+	Decades    []DecadeFacetData
+	Prices     []PriceFacetData
+}
+
+type CategoryFacetData struct {
+	CategoryID int
+	Name       string
+	Count      int
+}
+
+type DecadeFacetData struct {
+	// Decade is the first year of the decade, 1990 for the 1990s
+	Decade int
+	Count  int
+}
+
+type PriceFacetData struct {
+	// Bucket is the index of the price bucket, see PriceBucket
+	Bucket int
+	Count  int
+}
+
+// NewBookFacets constructs BookFacets from the provided data.
+func NewBookFacets(data NewBookFacetsData) (BookFacets, error) {
+	categories := make([]CategoryFacet, 0, len(data.Categories))
+	for _, category := range data.Categories {
+		if category.CategoryID <= 0 {
+			return BookFacets{}, fmt.Errorf("%w: category_id", ErrNegative)
+		}
+		if category.Count < 0 {
+			return BookFacets{}, fmt.Errorf("%w: count", ErrNegative)
+		}
+		categories = append(categories, CategoryFacet{categoryID: category.CategoryID, name: category.Name, count: category.Count})
+	}
+
+	decades := make([]DecadeFacet, 0, len(data.Decades))
+	for _, decade := range data.Decades {
+		if decade.Decade%10 != 0 {
+			return BookFacets{}, fmt.Errorf("%w: %d doesn't start a decade", ErrInvalidYearRange, decade.Decade)
+		}
+		if decade.Count < 0 {
+			return BookFacets{}, fmt.Errorf("%w: count", ErrNegative)
+		}
+		decades = append(decades, DecadeFacet{decade: decade.Decade, count: decade.Count})
+	}
+
+	prices := make([]PriceFacet, 0, len(data.Prices))
+	for _, price := range data.Prices {
+		minPrice, maxPrice, err := PriceBucket(price.Bucket)
+		if err != nil {
+			return BookFacets{}, err
+		}
+		if price.Count < 0 {
+			return BookFacets{}, fmt.Errorf("%w: count", ErrNegative)
+		}
+		prices = append(prices, PriceFacet{minPrice: minPrice, maxPrice: maxPrice, count: price.Count})
+	}
+
+	return BookFacets{
+		categories: categories,
+		decades:    decades,
+		prices:     prices,
+	}, nil
+}
+
+// Categories returns the counts per category.
+func (f BookFacets) Categories() []CategoryFacet {
+	return f.categories
+}
+
+// Decades returns the counts per decade.
+func (f BookFacets) Decades() []DecadeFacet {
+	return f.decades
+}
+
+// Prices returns the counts per price bucket.
+func (f BookFacets) Prices() []PriceFacet {
+	return f.prices
+}
+
+// CategoryID returns the identifier of the category.
+func (f CategoryFacet) CategoryID() int {
+	return f.categoryID
+}
+
+// Name returns the name of the category.
+func (f CategoryFacet) Name() string {
+	return f.name
+}
+
+// Count returns the number of books in the category.
+func (f CategoryFacet) Count() int {
+	return f.count
+}
+
+// Decade returns the first year of the decade.
+func (f DecadeFacet) Decade() int {
+	return f.decade
+}
+
+// Count returns the number of books published in the decade.
+func (f DecadeFacet) Count() int {
+	return f.count
+}
+
+// MinPrice returns the lowest price of the bucket.
+func (f PriceFacet) MinPrice() int {
+	return f.minPrice
+}
+
+// MaxPrice returns the highest price of the bucket, zero for the last bucket that has no upper bound.
+func (f PriceFacet) MaxPrice() int {
+	return f.maxPrice
+}
+
+// Count returns the number of books in the price bucket.
+func (f PriceFacet) Count() int {
+	return f.count
+}
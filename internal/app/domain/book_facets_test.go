@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceBucket(t *testing.T) {
+	testCases := []struct {
+		name        string
+		index       int
+		expectedMin int
+		expectedMax int
+	}{
+		{"First", 0, 0, 499},
+		{"Middle", 2, 1000, 1999},
+		{"Last", len(PriceBucketBounds()), 5000, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			minPrice, maxPrice, err := PriceBucket(tc.index)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMin, minPrice)
+			assert.Equal(t, tc.expectedMax, maxPrice)
+		})
+	}
+}
+
+func TestNewBookFacets_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewBookFacetsData
+		expectedErr error
+	}{
+		{"Zero category ID", NewBookFacetsData{Categories: []CategoryFacetData{{Name: "Fantasy", Count: 1}}}, ErrNegative},
+		{"Negative count", NewBookFacetsData{Decades: []DecadeFacetData{{Decade: 1990, Count: -1}}}, ErrNegative},
+		{"Not a decade", NewBookFacetsData{Decades: []DecadeFacetData{{Decade: 1995, Count: 1}}}, ErrInvalidYearRange},
+		{"Unknown price bucket", NewBookFacetsData{Prices: []PriceFacetData{{Bucket: len(PriceBucketBounds()) + 1, Count: 1}}}, ErrNegative},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			facets, err := NewBookFacets(tc.data)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, BookFacets{}, facets)
+		})
+	}
+}
+
+func TestNewBookFacets_Success(t *testing.T) {
+	// Act
+	facets, err := NewBookFacets(NewBookFacetsData{
+		Categories: []CategoryFacetData{{CategoryID: 3, Name: "Fantasy", Count: 312}},
+		Decades:    []DecadeFacetData{{Decade: 1990, Count: 58}},
+		Prices:     []PriceFacetData{{Bucket: 1, Count: 7}},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, facets.Categories(), 1)
+	assert.Equal(t, "Fantasy", facets.Categories()[0].Name())
+	assert.Equal(t, 312, facets.Categories()[0].Count())
+	require.Len(t, facets.Decades(), 1)
+	assert.Equal(t, 1990, facets.Decades()[0].Decade())
+	require.Len(t, facets.Prices(), 1)
+	assert.Equal(t, 500, facets.Prices()[0].MinPrice())
+	assert.Equal(t, 999, facets.Prices()[0].MaxPrice())
+	assert.Equal(t, 7, facets.Prices()[0].Count())
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSearchQueryLength is the longest full-text search query over books.
+	MaxSearchQueryLength = 200
+	// MaxAuthorFilterLength is the longest author books can be filtered by.
+	MaxAuthorFilterLength = 255
+)
+
+// BookSort is the order of the book listing.
+type BookSort string
+
+const (
+	// BookSortDefault orders the books by relevance when they are searched and by ID otherwise
+	BookSortDefault   BookSort = ""
+	BookSortID        BookSort = "id"
+	BookSortPriceAsc  BookSort = "price_asc"
+	BookSortPriceDesc BookSort = "price_desc"
+	BookSortYearAsc   BookSort = "year_asc"
+	BookSortYearDesc  BookSort = "year_desc"
+	BookSortTitle     BookSort = "title"
+	// BookSortNewest orders the books by when they were added to the catalogue, newest first
+	BookSortNewest BookSort = "newest"
+	// BookSortRelevance orders the searched books by their rank, it needs a search query
+	BookSortRelevance BookSort = "relevance"
+)
+
+// Valid reports whether the sort is known.
+func (s BookSort) Valid() bool {
+	switch s {
+	case BookSortDefault, BookSortID, BookSortPriceAsc, BookSortPriceDesc, BookSortYearAsc, BookSortYearDesc,
+		BookSortTitle, BookSortNewest, BookSortRelevance:
+		return true
+	}
+	return false
+}
+
+// BookFilter narrows down the book listing and orders it, its zero value lists every book in stock by ID.
+type BookFilter struct {
+	categoryIDs    []int
+	subcategories  bool
+	query          string
+	author         string
+	authorContains string
+	yearMin        int
+	yearMax        int
+	priceMin       int
+	priceMax       int
+	includeSoldOut bool
+	sort           BookSort
+}
+
+type NewBookFilterData struct {
+	CategoryIDs []int
+	// IncludeSubcategories matches the books of all the descendants of the categories too
+	IncludeSubcategories bool
+	// Query is searched in the titles and authors, the books are ranked by relevance
+	Query string
+	// Author matches the whole author ignoring the case
+	Author string
+	// AuthorContains matches a part of the author ignoring the case
+	AuthorContains string
+	// YearMin, YearMax, PriceMin and PriceMax bound the ranges inclusively, zero leaves the bound open
+	YearMin  int
+	YearMax  int
+	PriceMin int
+	PriceMax int
+	// IncludeSoldOut lists the books that are out of stock too
+	IncludeSoldOut bool
+	// Sort orders the books, ties are broken by ID
+	Sort BookSort
+}
+
+// NewBookFilter constructs a BookFilter from the provided data.
+func NewBookFilter(data NewBookFilterData) (BookFilter, error) {
+	for _, categoryID := range data.CategoryIDs {
+		if categoryID <= 0 {
+			return BookFilter{}, fmt.Errorf("%w: category_id", ErrNegative)
+		}
+	}
+
+	query := strings.TrimSpace(data.Query)
+	if utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		return BookFilter{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidSearchQuery, MaxSearchQueryLength)
+	}
+
+	author := strings.TrimSpace(data.Author)
+	authorContains := strings.TrimSpace(data.AuthorContains)
+	if utf8.RuneCountInString(author) > MaxAuthorFilterLength || utf8.RuneCountInString(authorContains) > MaxAuthorFilterLength {
+		return BookFilter{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidAuthorFilter, MaxAuthorFilterLength)
+	}
+
+	if data.YearMin < 0 || data.YearMax < 0 {
+		return BookFilter{}, fmt.Errorf("%w: negative year", ErrInvalidYearRange)
+	}
+	if data.YearMax > 0 && data.YearMin > data.YearMax {
+		return BookFilter{}, fmt.Errorf("%w: %d is after %d", ErrInvalidYearRange, data.YearMin, data.YearMax)
+	}
+
+	if data.PriceMin < 0 || data.PriceMax < 0 {
+		return BookFilter{}, fmt.Errorf("%w: negative price", ErrInvalidPriceRange)
+	}
+	if data.PriceMax > 0 && data.PriceMin > data.PriceMax {
+		return BookFilter{}, fmt.Errorf("%w: %d is more than %d", ErrInvalidPriceRange, data.PriceMin, data.PriceMax)
+	}
+
+	if !data.Sort.Valid() {
+		return BookFilter{}, fmt.Errorf("%w: %q", ErrInvalidBookSort, data.Sort)
+	}
+	if data.Sort == BookSortRelevance && query == "" {
+		return BookFilter{}, fmt.Errorf("%w: relevance needs a search query", ErrInvalidBookSort)
+	}
+
+	return BookFilter{
+		categoryIDs:    data.CategoryIDs,
+		subcategories:  data.IncludeSubcategories,
+		query:          query,
+		author:         author,
+		authorContains: authorContains,
+		yearMin:        data.YearMin,
+		yearMax:        data.YearMax,
+		priceMin:       data.PriceMin,
+		priceMax:       data.PriceMax,
+		includeSoldOut: data.IncludeSoldOut,
+		sort:           data.Sort,
+	}, nil
+}
+
+// CategoryIDs returns the categories the books belong to, all categories when empty.
+func (f BookFilter) CategoryIDs() []int {
+	return f.categoryIDs
+}
+
+// IncludeSubcategories reports whether the books of the descendants of the categories match too.
+func (f BookFilter) IncludeSubcategories() bool {
+	return f.subcategories
+}
+
+// Query returns the full-text search query, empty when the books aren't searched.
+func (f BookFilter) Query() string {
+	return f.query
+}
+
+// Author returns the whole author the books are written by, empty for any author.
+func (f BookFilter) Author() string {
+	return f.author
+}
+
+// AuthorContains returns a part of the author the books are written by, empty for any author.
+func (f BookFilter) AuthorContains() string {
+	return f.authorContains
+}
+
+// YearMin returns the earliest year of the books, zero when it isn't bounded.
+func (f BookFilter) YearMin() int {
+	return f.yearMin
+}
+
+// YearMax returns the latest year of the books, zero when it isn't bounded.
+func (f BookFilter) YearMax() int {
+	return f.yearMax
+}
+
+// PriceMin returns the lowest price of the books, zero when it isn't bounded.
+func (f BookFilter) PriceMin() int {
+	return f.priceMin
+}
+
+// PriceMax returns the highest price of the books, zero when it isn't bounded.
+func (f BookFilter) PriceMax() int {
+	return f.priceMax
+}
+
+// IncludeSoldOut reports whether the books that are out of stock are listed too.
+func (f BookFilter) IncludeSoldOut() bool {
+	return f.includeSoldOut
+}
+
+// Sort returns the order of the books, the default one is resolved to relevance or ID.
+func (f BookFilter) Sort() BookSort {
+	if f.sort != BookSortDefault {
+		return f.sort
+	}
+	if f.query != "" {
+		return BookSortRelevance
+	}
+	return BookSortID
+}
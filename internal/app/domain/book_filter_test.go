@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookFilter_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewBookFilterData
+		expectedErr error
+	}{
+		{"Zero category ID", NewBookFilterData{CategoryIDs: []int{1, 0}}, ErrNegative},
+		{"Long query", NewBookFilterData{Query: strings.Repeat("a", MaxSearchQueryLength+1)}, ErrInvalidSearchQuery},
+		{"Long author", NewBookFilterData{Author: strings.Repeat("a", MaxAuthorFilterLength+1)}, ErrInvalidAuthorFilter},
+		{"Long partial author", NewBookFilterData{AuthorContains: strings.Repeat("a", MaxAuthorFilterLength+1)}, ErrInvalidAuthorFilter},
+		{"Negative year", NewBookFilterData{YearMin: -1}, ErrInvalidYearRange},
+		{"Reversed years", NewBookFilterData{YearMin: 2000, YearMax: 1990}, ErrInvalidYearRange},
+		{"Negative price", NewBookFilterData{PriceMax: -1}, ErrInvalidPriceRange},
+		{"Reversed prices", NewBookFilterData{PriceMin: 2000, PriceMax: 1000}, ErrInvalidPriceRange},
+		{"Unknown sort", NewBookFilterData{Sort: "popularity"}, ErrInvalidBookSort},
+		{"Relevance without query", NewBookFilterData{Sort: BookSortRelevance}, ErrInvalidBookSort},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			filter, err := NewBookFilter(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, BookFilter{}, filter)
+		})
+	}
+}
+
+func TestNewBookFilter_OpenBounds(t *testing.T) {
+	// Act
+	filter, err := NewBookFilter(NewBookFilterData{
+		Query:          "  lord of the rings ",
+		AuthorContains: " tolk ",
+		YearMin:        1950,
+		PriceMin:       500,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "lord of the rings", filter.Query())
+	assert.Equal(t, "tolk", filter.AuthorContains())
+	assert.Equal(t, 1950, filter.YearMin())
+	assert.Zero(t, filter.YearMax())
+	assert.Equal(t, 500, filter.PriceMin())
+	assert.Zero(t, filter.PriceMax())
+	assert.False(t, filter.IncludeSoldOut())
+}
+
+func TestBookFilter_Sort(t *testing.T) {
+	testCases := []struct {
+		name         string
+		data         NewBookFilterData
+		expectedSort BookSort
+	}{
+		{"Default", NewBookFilterData{}, BookSortID},
+		{"Default when searching", NewBookFilterData{Query: "hobbit"}, BookSortRelevance},
+		{"Chosen when searching", NewBookFilterData{Query: "hobbit", Sort: BookSortPriceAsc}, BookSortPriceAsc},
+		{"Chosen", NewBookFilterData{Sort: BookSortNewest}, BookSortNewest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			filter, err := NewBookFilter(tc.data)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSort, filter.Sort())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MinSuggestPrefixLength is the shortest prefix books are suggested for, shorter ones match too much.
+	MinSuggestPrefixLength = 2
+	// MaxSuggestPrefixLength is the longest prefix books are suggested for.
+	MaxSuggestPrefixLength = 100
+	// DefaultSuggestLimit is the number of suggestions returned when no limit is asked for.
+	DefaultSuggestLimit = 10
+	// MaxSuggestLimit is the largest number of suggestions returned at once.
+	MaxSuggestLimit = 20
+)
+
+// SuggestionKind tells whether a suggestion is a title or an author.
+type SuggestionKind string
+
+const (
+	SuggestionTitle  SuggestionKind = "title"
+	SuggestionAuthor SuggestionKind = "author"
+)
+
+// BookSuggestion is a title or an author of books in stock that resembles what the user is typing.
+type BookSuggestion struct {
+	text  string
+	kind  SuggestionKind
+	score float64
+}
+
+type NewBookSuggestionData struct {
+	Text string
+	Kind SuggestionKind
+	// Score is the trigram word similarity of the prefix and the text, from 0 to 1
+	Score float64
+}
+
+// NewBookSuggestion constructs a BookSuggestion from the provided data.
+func NewBookSuggestion(data NewBookSuggestionData) (BookSuggestion, error) {
+	if strings.TrimSpace(data.Text) == "" {
+		return BookSuggestion{}, fmt.Errorf("%w: text", ErrRequired)
+	}
+	if data.Kind != SuggestionTitle && data.Kind != SuggestionAuthor {
+		return BookSuggestion{}, fmt.Errorf("%w: title or author kind, got %q", ErrRequired, data.Kind)
+	}
+	if data.Score < 0 {
+		return BookSuggestion{}, fmt.Errorf("%w: score", ErrNegative)
+	}
+
+	return BookSuggestion{
+		text:  data.Text,
+		kind:  data.Kind,
+		score: data.Score,
+	}, nil
+}
+
+// Text returns the suggested title or author.
+func (s BookSuggestion) Text() string {
+	return s.text
+}
+
+// Kind returns whether the suggestion is a title or an author.
+func (s BookSuggestion) Kind() SuggestionKind {
+	return s.kind
+}
+
+// Score returns how well the suggestion matches the prefix, 1 for a perfect match.
+func (s BookSuggestion) Score() float64 {
+	return s.score
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookSuggestion_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewBookSuggestionData
+		expectedErr error
+	}{
+		{"Blank text", NewBookSuggestionData{Text: " ", Kind: SuggestionTitle}, ErrRequired},
+		{"Unknown kind", NewBookSuggestionData{Text: "Tolkien", Kind: "publisher"}, ErrRequired},
+		{"Negative score", NewBookSuggestionData{Text: "Tolkien", Kind: SuggestionAuthor, Score: -0.1}, ErrNegative},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			suggestion, err := NewBookSuggestion(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, BookSuggestion{}, suggestion)
+		})
+	}
+}
+
+func TestNewBookSuggestion_Success(t *testing.T) {
+	// Act
+	suggestion, err := NewBookSuggestion(NewBookSuggestionData{Text: "J. R. R. Tolkien", Kind: SuggestionAuthor, Score: 0.5})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "J. R. R. Tolkien", suggestion.Text())
+	assert.Equal(t, SuggestionAuthor, suggestion.Kind())
+	assert.InDelta(t, 0.5, suggestion.Score(), 1e-9)
+}
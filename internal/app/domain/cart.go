@@ -2,17 +2,35 @@ package domain
 
 import (
 	"fmt"
+	"maps"
 	"slices"
+	"time"
 )
 
+// CartReservationTTL is how long a book stays reserved after it was put in a cart.
+const CartReservationTTL = 30 * time.Minute
+
+// MaxCartQuantity is the largest number of copies of one book a cart can hold.
+const MaxCartQuantity = 100
+
+// MaxCartTokenLength is the longest guest cart token a client may send.
+const MaxCartTokenLength = 64
+
+// Cart holds the books a user is going to buy and how many copies of each.
+// A guest cart belongs to an anonymous visitor, it has a token instead of a user ID.
 type Cart struct {
-	userID  int
-	bookIDs []int
+	userID     int
+	token      string
+	bookIDs    []int
+	quantities map[int]int
 }
 
+// NewCartData describes the books of a cart. Every book in BookIDs is one copy,
+// Quantities sets the number of copies per book ID and takes precedence.
 type NewCartData struct {
-	UserID  int
-	BookIDs []int
+	UserID     int
+	BookIDs    []int
+	Quantities map[int]int
 }
 
 // NewCart constructs a Cart from the provided data.
@@ -20,19 +38,84 @@ func NewCart(data NewCartData) (Cart, error) {
 	if data.UserID == 0 {
 		return Cart{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
 	}
-	if len(data.BookIDs) == 0 {
+	if len(data.BookIDs) == 0 && len(data.Quantities) == 0 {
 		return Cart{}, fmt.Errorf("%w: book_ids", ErrNil)
 	}
 
+	cart, err := NewEmptyCart(data.UserID)
+	if err != nil {
+		return Cart{}, err
+	}
+
 	uniqueBookIDs, err := removeDuplicates(data.BookIDs)
 	if err != nil {
 		return Cart{}, err
 	}
+	for _, bookID := range uniqueBookIDs {
+		cart.AddBook(bookID)
+	}
+
+	// map iteration order is random, the books are added in ID order
+	quantityBookIDs := make([]int, 0, len(data.Quantities))
+	for bookID := range data.Quantities {
+		quantityBookIDs = append(quantityBookIDs, bookID)
+	}
+	slices.Sort(quantityBookIDs)
+	for _, bookID := range quantityBookIDs {
+		if bookID <= 0 {
+			return Cart{}, fmt.Errorf("%w: bookID", ErrNegative)
+		}
+		if data.Quantities[bookID] <= 0 {
+			return Cart{}, fmt.Errorf("%w: quantity of book %d", ErrInvalidQuantity, bookID)
+		}
+		err := cart.SetQuantity(bookID, data.Quantities[bookID])
+		if err != nil {
+			return Cart{}, err
+		}
+	}
+
+	return cart, nil
+}
+
+// NewEmptyCart constructs a Cart without books, for a user who has no cart yet.
+func NewEmptyCart(userID int) (Cart, error) {
+	if userID == 0 {
+		return Cart{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	return Cart{
+		userID:     userID,
+		bookIDs:    []int{},
+		quantities: map[int]int{},
+	}, nil
+}
+
+// NewGuestCart constructs a Cart without books for the anonymous visitor holding the token.
+func NewGuestCart(token string) (Cart, error) {
+	if err := ValidateCartToken(token); err != nil {
+		return Cart{}, err
+	}
 	return Cart{
-		userID:  data.UserID,
-		bookIDs: uniqueBookIDs,
+		token:      token,
+		bookIDs:    []int{},
+		quantities: map[int]int{},
 	}, nil
+}
 
+// ValidateCartToken checks that a guest cart token sent by a client can be used.
+func ValidateCartToken(token string) error {
+	if token == "" {
+		return fmt.Errorf("%w: cart token", ErrRequired)
+	}
+	if len(token) > MaxCartTokenLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidCartToken, MaxCartTokenLength)
+	}
+	for _, r := range token {
+		// tokens are URL-safe base64
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return fmt.Errorf("%w: unexpected character %q", ErrInvalidCartToken, r)
+		}
+	}
+	return nil
 }
 
 func removeDuplicates(bookIDs []int) ([]int, error) {
@@ -57,16 +140,52 @@ func (c *Cart) UserID() int {
 	return c.userID
 }
 
-// BookIDs returns the map of book IDs currently in the cart.
+// Token returns the guest cart token, it is empty for the cart of a signed in user.
+func (c *Cart) Token() string {
+	return c.token
+}
+
+// IsGuest reports whether the cart belongs to an anonymous visitor.
+func (c *Cart) IsGuest() bool {
+	return c.token != ""
+}
+
+// BookIDs returns the IDs of the books currently in the cart in the order they were added.
 func (c *Cart) BookIDs() []int {
 	return c.bookIDs
 }
 
-// AddBook adds a book to the cart by its ID.
+// Quantity returns the number of copies of the book in the cart, zero if the book is not there.
+func (c *Cart) Quantity(bookID int) int {
+	return c.quantities[bookID]
+}
+
+// AddBook adds one copy of a book to the cart by its ID, a book that is already in the cart is left as is.
 func (c *Cart) AddBook(bookID int) {
+	if !c.HasBook(bookID) {
+		_ = c.SetQuantity(bookID, 1)
+	}
+}
+
+// SetQuantity sets the number of copies of a book, zero removes the book from the cart.
+func (c *Cart) SetQuantity(bookID, quantity int) error {
+	if quantity < 0 || quantity > MaxCartQuantity {
+		return fmt.Errorf("%w: %d, must be between 1 and %d", ErrInvalidQuantity, quantity, MaxCartQuantity)
+	}
+	if quantity == 0 {
+		c.RemoveBook(bookID)
+		return nil
+	}
+
+	if c.quantities == nil {
+		c.quantities = make(map[int]int)
+	}
 	if !c.HasBook(bookID) {
 		c.bookIDs = append(c.bookIDs, bookID)
 	}
+	c.quantities[bookID] = quantity
+
+	return nil
 }
 
 // RemoveBook removes a book from the cart by its ID.
@@ -77,11 +196,13 @@ func (c *Cart) RemoveBook(bookID int) {
 			break
 		}
 	}
+	delete(c.quantities, bookID)
 }
 
 // Clear removes all books from the cart.
 func (c *Cart) Clear() {
 	c.bookIDs = c.bookIDs[:0]
+	clear(c.quantities)
 }
 
 // HasBook checks if a book with the given ID exists in the cart.
@@ -96,7 +217,7 @@ func (c *Cart) HasBooks() bool {
 
 // Equal compares two carts for equality.
 func (c *Cart) Equal(other Cart) bool {
-	if c.UserID() != other.UserID() {
+	if c.UserID() != other.UserID() || c.Token() != other.Token() {
 		return false
 	}
 
@@ -105,7 +226,7 @@ func (c *Cart) Equal(other Cart) bool {
 	}
 
 	for _, bookID := range other.BookIDs() {
-		if !c.HasBook(bookID) {
+		if c.Quantity(bookID) != other.Quantity(bookID) {
 			return false
 		}
 	}
@@ -113,32 +234,125 @@ func (c *Cart) Equal(other Cart) bool {
 	return true
 }
 
-// Diff returns a new cart containing only the books that exist in this cart
+// Clone returns a copy of the cart that can be changed without affecting this one.
+func (c *Cart) Clone() Cart {
+	return Cart{
+		userID:     c.userID,
+		token:      c.token,
+		bookIDs:    slices.Clone(c.bookIDs),
+		quantities: maps.Clone(c.quantities),
+	}
+}
+
+// Diff returns a new cart containing only the copies that exist in this cart but not in the old one
 func (c *Cart) Diff(old Cart) Cart {
-	diff, _ := NewCart(NewCartData{
-		UserID: c.UserID(),
-	})
+	diff := c.empty()
 
 	for _, bookID := range c.BookIDs() {
-		if !old.HasBook(bookID) {
-			diff.AddBook(bookID)
+		if added := c.Quantity(bookID) - old.Quantity(bookID); added > 0 {
+			_ = diff.SetQuantity(bookID, added)
 		}
 	}
 
 	return diff
 }
 
-// Merge combines books from this cart and the old cart into a new cart.
+// empty returns a cart of the same owner without books.
+func (c *Cart) empty() Cart {
+	return Cart{
+		userID:     c.userID,
+		token:      c.token,
+		bookIDs:    []int{},
+		quantities: map[int]int{},
+	}
+}
+
+// Merge combines books from this cart and the old cart into a new cart of this cart's owner.
+// The copies of a book in both carts are added up to MaxCartQuantity.
 func (c *Cart) Merge(old Cart) Cart {
-	totalSize := len(c.BookIDs()) + len(old.BookIDs())
-	allBooks := make([]int, 0, totalSize)
-	allBooks = append(allBooks, c.bookIDs...)
-	allBooks = append(allBooks, old.bookIDs...)
+	merged := c.empty()
 
-	merged, _ := NewCart(NewCartData{
-		UserID:  c.UserID(),
-		BookIDs: allBooks,
-	})
+	for _, cart := range []*Cart{c, &old} {
+		for _, bookID := range cart.BookIDs() {
+			quantity := min(merged.Quantity(bookID)+cart.Quantity(bookID), MaxCartQuantity)
+			_ = merged.SetQuantity(bookID, quantity)
+		}
+	}
 
 	return merged
 }
+
+// CartItem is a book reserved in a cart.
+// Every book is reserved on its own and is released once its reservation expires.
+type CartItem struct {
+	bookID     int
+	title      string
+	price      int
+	quantity   int
+	reservedAt time.Time
+	expiresAt  time.Time
+}
+
+type NewCartItemData struct {
+	BookID     int
+	Title      string
+	Price      int
+	Quantity   int
+	ReservedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// NewCartItem constructs a CartItem from the provided data.
+func NewCartItem(data NewCartItemData) (CartItem, error) {
+	if data.BookID <= 0 {
+		return CartItem{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+	if data.Title == "" {
+		return CartItem{}, fmt.Errorf("%w: title", ErrRequired)
+	}
+	if data.Price < 0 {
+		return CartItem{}, fmt.Errorf("%w: price", ErrNegative)
+	}
+	if data.Quantity <= 0 || data.Quantity > MaxCartQuantity {
+		return CartItem{}, fmt.Errorf("%w: %d", ErrInvalidQuantity, data.Quantity)
+	}
+
+	return CartItem{
+		bookID:     data.BookID,
+		title:      data.Title,
+		price:      data.Price,
+		quantity:   data.Quantity,
+		reservedAt: data.ReservedAt,
+		expiresAt:  data.ExpiresAt,
+	}, nil
+}
+
+// BookID returns the identifier of the reserved book.
+func (i CartItem) BookID() int {
+	return i.bookID
+}
+
+// Title returns the book title.
+func (i CartItem) Title() string {
+	return i.title
+}
+
+// Price returns the current book price.
+func (i CartItem) Price() int {
+	return i.price
+}
+
+// Quantity returns the number of reserved copies.
+func (i CartItem) Quantity() int {
+	return i.quantity
+}
+
+// ReservedAt returns the time the book was put in the cart.
+func (i CartItem) ReservedAt() time.Time {
+	return i.reservedAt
+}
+
+// ExpiresAt returns the time the reservation is released unless the cart is checked out.
+func (i CartItem) ExpiresAt() time.Time {
+	return i.expiresAt
+}
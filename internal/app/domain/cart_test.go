@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCart_BookIDsAndQuantities(t *testing.T) {
+	// Arrange
+	cartData := NewCartData{
+		UserID:     7,
+		BookIDs:    []int{1, 2, 1},
+		Quantities: map[int]int{2: 5, 3: 2},
+	}
+
+	// Act
+	cart, err := NewCart(cartData)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, cart.BookIDs())
+	assert.Equal(t, 1, cart.Quantity(1))
+	assert.Equal(t, 5, cart.Quantity(2))
+	assert.Equal(t, 2, cart.Quantity(3))
+}
+
+func TestNewCart_InvalidQuantity_ReturnsInvalidQuantityError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		quantities map[int]int
+	}{
+		{"Zero", map[int]int{1: 0}},
+		{"Negative", map[int]int{1: -2}},
+		{"Above the limit", map[int]int{1: MaxCartQuantity + 1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			cart, err := NewCart(NewCartData{UserID: 7, Quantities: tc.quantities})
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidQuantity)
+			assert.Equal(t, Cart{}, cart)
+		})
+	}
+}
+
+func TestCart_SetQuantity_ZeroRemovesBook(t *testing.T) {
+	// Arrange
+	cart, err := NewCart(NewCartData{UserID: 7, Quantities: map[int]int{1: 2, 2: 3}})
+	require.NoError(t, err)
+
+	// Act
+	err = cart.SetQuantity(1, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, cart.HasBook(1))
+	assert.Equal(t, []int{2}, cart.BookIDs())
+}
+
+func TestCart_DiffAndMerge_CountCopies(t *testing.T) {
+	// Arrange
+	oldCart, err := NewCart(NewCartData{UserID: 7, Quantities: map[int]int{1: 2, 2: 1}})
+	require.NoError(t, err)
+	newCart, err := NewCart(NewCartData{UserID: 7, Quantities: map[int]int{1: 5, 3: MaxCartQuantity}})
+	require.NoError(t, err)
+	extraCart, err := NewCart(NewCartData{UserID: 7, Quantities: map[int]int{3: 1}})
+	require.NoError(t, err)
+
+	// Act
+	diff := newCart.Diff(oldCart)
+	merged := newCart.Merge(extraCart)
+
+	// Assert
+	assert.Equal(t, []int{1, 3}, diff.BookIDs())
+	assert.Equal(t, 3, diff.Quantity(1))
+	assert.Equal(t, MaxCartQuantity, diff.Quantity(3))
+	assert.Equal(t, MaxCartQuantity, merged.Quantity(3))
+	assert.Equal(t, 5, merged.Quantity(1))
+}
+
+func TestNewCartItem_ValidData(t *testing.T) {
+	// Arrange
+	reservedAt := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := reservedAt.Add(CartReservationTTL)
+
+	// Act
+	item, err := NewCartItem(NewCartItemData{BookID: 1, Title: "Clean Architecture", Price: 2999, Quantity: 3, ReservedAt: reservedAt, ExpiresAt: expiresAt})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, item.BookID())
+	assert.Equal(t, "Clean Architecture", item.Title())
+	assert.Equal(t, 2999, item.Price())
+	assert.Equal(t, 3, item.Quantity())
+	assert.Equal(t, reservedAt, item.ReservedAt())
+	assert.Equal(t, expiresAt, item.ExpiresAt())
+}
+
+func TestNewCartItem_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewCartItemData
+		expectedErr error
+	}{
+		{"Zero book ID", NewCartItemData{Title: "Valid Title", Price: 1000, Quantity: 1}, ErrNegative},
+		{"Empty title", NewCartItemData{BookID: 1, Price: 1000, Quantity: 1}, ErrRequired},
+		{"Negative price", NewCartItemData{BookID: 1, Title: "Valid Title", Price: -1, Quantity: 1}, ErrNegative},
+		{"Zero quantity", NewCartItemData{BookID: 1, Title: "Valid Title", Price: 1000}, ErrInvalidQuantity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			item, err := NewCartItem(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, CartItem{}, item)
+		})
+	}
+}
+
+func TestValidateCartToken(t *testing.T) {
+	testCases := []struct {
+		name        string
+		token       string
+		expectedErr error
+	}{
+		{"Valid", "Zm9vYmFy-_09", nil},
+		{"Empty", "", ErrRequired},
+		{"Too long", strings.Repeat("a", MaxCartTokenLength+1), ErrInvalidCartToken},
+		{"Not URL-safe", "a/b", ErrInvalidCartToken},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			err := ValidateCartToken(tc.token)
+
+			// Assert
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCart_Merge_GuestCartIntoUserCart(t *testing.T) {
+	// Arrange
+	userCart, err := NewCart(NewCartData{UserID: 7, Quantities: map[int]int{1: 2}})
+	require.NoError(t, err)
+	guestCart, err := NewGuestCart("guest-token")
+	require.NoError(t, err)
+	require.NoError(t, guestCart.SetQuantity(1, 1))
+	require.NoError(t, guestCart.SetQuantity(2, 3))
+
+	// Act
+	merged := userCart.Merge(guestCart)
+
+	// Assert
+	assert.Equal(t, 7, merged.UserID())
+	assert.False(t, merged.IsGuest())
+	assert.Equal(t, []int{1, 2}, merged.BookIDs())
+	assert.Equal(t, 3, merged.Quantity(1))
+	assert.Equal(t, 3, merged.Quantity(2))
+	assert.Equal(t, 2, userCart.Quantity(1))
+}
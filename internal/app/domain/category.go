@@ -1,15 +1,48 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxCategorySlugLength is the longest slug a category can have.
+	MaxCategorySlugLength = 100
+	// MaxCategoryDescriptionLength is the longest description a category can have.
+	MaxCategoryDescriptionLength = 1000
+	// fallbackCategorySlug is generated for the names that have no ASCII letters or digits
+	fallbackCategorySlug = "category"
+)
+
+// categorySlugPattern matches lowercase ASCII words joined by single dashes
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
 
 type Category struct {
 	id   int
 	name string
+	// parentID is zero for the root categories
+	parentID int
+	// slug identifies the category in the storefront URLs
+	slug          string
+	slugGenerated bool
+	description   string
+	displayOrder  int
+	// bookCount is the number of books in stock in the category, it's only read from the database
+	bookCount int
 }
 
 type NewCategoryData struct {
-	ID   int
-	Name string
+	ID       int
+	Name     string
+	ParentID int
+	// Slug is generated from the name when it's empty
+	Slug        string
+	Description string
+	// DisplayOrder orders the categories among their siblings, the lower first
+	DisplayOrder int
+	BookCount    int
 }
 
 // NewCategory constructs a Category from the provided data.
@@ -17,12 +50,72 @@ func NewCategory(data NewCategoryData) (Category, error) {
 	if data.Name == "" {
 		return Category{}, fmt.Errorf("%w: name", ErrRequired)
 	}
+	if data.ParentID < 0 {
+		return Category{}, fmt.Errorf("%w: parent_id", ErrNegative)
+	}
+	if data.ID != 0 && data.ParentID == data.ID {
+		return Category{}, fmt.Errorf("%w: %d can't be its own parent", ErrCategoryCycle, data.ID)
+	}
+
+	slug, generated := data.Slug, false
+	if slug == "" {
+		slug, generated = Slugify(data.Name), true
+	}
+	if len(slug) > MaxCategorySlugLength || !categorySlugPattern.MatchString(slug) {
+		return Category{}, fmt.Errorf("%w: %q isn't lowercase letters, digits and dashes of at most %d characters", ErrInvalidCategorySlug, slug, MaxCategorySlugLength)
+	}
+
+	description := strings.TrimSpace(data.Description)
+	if utf8.RuneCountInString(description) > MaxCategoryDescriptionLength {
+		return Category{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidCategoryDescription, MaxCategoryDescriptionLength)
+	}
+	if data.BookCount < 0 {
+		return Category{}, fmt.Errorf("%w: book_count", ErrNegative)
+	}
+
 	return Category{
-		id:   data.ID,
-		name: data.Name,
+		id:            data.ID,
+		name:          data.Name,
+		parentID:      data.ParentID,
+		slug:          slug,
+		slugGenerated: generated,
+		description:   description,
+		displayOrder:  data.DisplayOrder,
+		bookCount:     data.BookCount,
 	}, nil
 }
 
+// Slugify turns the name into a URL-safe slug, the runes other than ASCII letters and digits become dashes.
+func Slugify(name string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			dash = true
+			continue
+		}
+
+		separator := dash && slug.Len() > 0
+		length := slug.Len() + 1
+		if separator {
+			length++
+		}
+		if length > MaxCategorySlugLength {
+			break
+		}
+		if separator {
+			slug.WriteByte('-')
+		}
+		slug.WriteRune(r)
+		dash = false
+	}
+
+	if slug.Len() == 0 {
+		return fallbackCategorySlug
+	}
+	return slug.String()
+}
+
 // ID returns the category identifier.
 func (c Category) ID() int {
 	return c.id
@@ -32,3 +125,33 @@ func (c Category) ID() int {
 func (c Category) Name() string {
 	return c.name
 }
+
+// ParentID returns the identifier of the parent category, zero for the root categories.
+func (c Category) ParentID() int {
+	return c.parentID
+}
+
+// Slug returns the URL-safe identifier of the category.
+func (c Category) Slug() string {
+	return c.slug
+}
+
+// SlugGenerated reports whether the slug was generated from the name, a taken generated slug gets a numeric suffix.
+func (c Category) SlugGenerated() bool {
+	return c.slugGenerated
+}
+
+// Description returns the category description.
+func (c Category) Description() string {
+	return c.description
+}
+
+// DisplayOrder returns the position of the category among its siblings, the lower first.
+func (c Category) DisplayOrder() int {
+	return c.displayOrder
+}
+
+// BookCount returns the number of books in stock in the category.
+func (c Category) BookCount() int {
+	return c.bookCount
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "fmt"
+
+// CategoryDeletePolicy tells what happens to the books of a category when it's deleted.
+type CategoryDeletePolicy string
+
+const (
+	// CategoryDeleteRefuse keeps the category when it still has books, it's the default
+	CategoryDeleteRefuse CategoryDeletePolicy = "refuse"
+	// CategoryDeleteReassign moves the books to another category
+	CategoryDeleteReassign CategoryDeletePolicy = "reassign"
+	// CategoryDeleteArchive archives the books left without a category, they disappear from the listings and can't be
+	// reserved anymore. The books that belong to other categories only leave the deleted one
+	CategoryDeleteArchive CategoryDeletePolicy = "archive"
+)
+
+// CategoryDeletion is the way a category is deleted.
+type CategoryDeletion struct {
+	policy     CategoryDeletePolicy
+	reassignTo int
+}
+
+type NewCategoryDeletionData struct {
+	// Policy defaults to CategoryDeleteRefuse when empty
+	Policy CategoryDeletePolicy
+	// ReassignTo is the category the books move to, only with CategoryDeleteReassign
+	ReassignTo int
+}
+
+// NewCategoryDeletion constructs a CategoryDeletion from the provided data.
+func NewCategoryDeletion(data NewCategoryDeletionData) (CategoryDeletion, error) {
+	policy := data.Policy
+	if policy == "" {
+		policy = CategoryDeleteRefuse
+	}
+
+	switch policy {
+	case CategoryDeleteReassign:
+		if data.ReassignTo <= 0 {
+			return CategoryDeletion{}, fmt.Errorf("%w: reassign needs the category to move the books to", ErrInvalidDeletePolicy)
+		}
+	case CategoryDeleteRefuse, CategoryDeleteArchive:
+		if data.ReassignTo != 0 {
+			return CategoryDeletion{}, fmt.Errorf("%w: only reassign moves the books to another category", ErrInvalidDeletePolicy)
+		}
+	default:
+		return CategoryDeletion{}, fmt.Errorf("%w: %q", ErrInvalidDeletePolicy, data.Policy)
+	}
+
+	return CategoryDeletion{
+		policy:     policy,
+		reassignTo: data.ReassignTo,
+	}, nil
+}
+
+// Policy returns what happens to the books of the category.
+func (d CategoryDeletion) Policy() CategoryDeletePolicy {
+	return d.policy
+}
+
+// ReassignTo returns the category the books move to, zero unless they are reassigned.
+func (d CategoryDeletion) ReassignTo() int {
+	return d.reassignTo
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryDeletion_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name string
+		data NewCategoryDeletionData
+	}{
+		{"Unknown policy", NewCategoryDeletionData{Policy: "cascade"}},
+		{"Reassign without a category", NewCategoryDeletionData{Policy: CategoryDeleteReassign}},
+		{"Refuse with a category", NewCategoryDeletionData{ReassignTo: 2}},
+		{"Archive with a category", NewCategoryDeletionData{Policy: CategoryDeleteArchive, ReassignTo: 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			deletion, err := NewCategoryDeletion(tc.data)
+
+			// Assert
+			require.ErrorIs(t, err, ErrInvalidDeletePolicy)
+			assert.Equal(t, CategoryDeletion{}, deletion)
+		})
+	}
+}
+
+func TestNewCategoryDeletion_Success(t *testing.T) {
+	testCases := []struct {
+		name               string
+		data               NewCategoryDeletionData
+		expectedPolicy     CategoryDeletePolicy
+		expectedReassignTo int
+	}{
+		{"Refuse by default", NewCategoryDeletionData{}, CategoryDeleteRefuse, 0},
+		{"Reassign", NewCategoryDeletionData{Policy: CategoryDeleteReassign, ReassignTo: 2}, CategoryDeleteReassign, 2},
+		{"Archive", NewCategoryDeletionData{Policy: CategoryDeleteArchive}, CategoryDeleteArchive, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			deletion, err := NewCategoryDeletion(tc.data)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPolicy, deletion.Policy())
+			assert.Equal(t, tc.expectedReassignTo, deletion.ReassignTo())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Words", "Science Fiction", "science-fiction"},
+		{"Punctuation", "  Sci-Fi & Fantasy!  ", "sci-fi-fantasy"},
+		{"Digits", "Books of the 1990s", "books-of-the-1990s"},
+		{"Non-ASCII letters", "Café Crème", "caf-cr-me"},
+		{"No ASCII at all", "Детективы", "category"},
+		{"Too long", strings.Repeat("a", MaxCategorySlugLength+10), strings.Repeat("a", MaxCategorySlugLength)},
+		{"Too long at a dash", strings.Repeat("a", MaxCategorySlugLength-1) + " b", strings.Repeat("a", MaxCategorySlugLength-1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			slug := Slugify(tc.input)
+
+			// Assert
+			assert.Equal(t, tc.expected, slug)
+		})
+	}
+}
+
+func TestNewCategory_GeneratedSlug(t *testing.T) {
+	// Act
+	category, err := NewCategory(NewCategoryData{Name: "Science Fiction", Description: "  Rockets and robots  ", DisplayOrder: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "science-fiction", category.Slug())
+	assert.True(t, category.SlugGenerated())
+	assert.Equal(t, "Rockets and robots", category.Description())
+	assert.Equal(t, 2, category.DisplayOrder())
+}
+
+func TestNewCategory_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewCategoryData
+		expectedErr error
+	}{
+		{"Uppercase slug", NewCategoryData{Name: "Fantasy", Slug: "Fantasy"}, ErrInvalidCategorySlug},
+		{"Double dash", NewCategoryData{Name: "Fantasy", Slug: "high--fantasy"}, ErrInvalidCategorySlug},
+		{"Trailing dash", NewCategoryData{Name: "Fantasy", Slug: "fantasy-"}, ErrInvalidCategorySlug},
+		{"Long slug", NewCategoryData{Name: "Fantasy", Slug: strings.Repeat("a", MaxCategorySlugLength+1)}, ErrInvalidCategorySlug},
+		{"Long description", NewCategoryData{Name: "Fantasy", Description: strings.Repeat("a", MaxCategoryDescriptionLength+1)}, ErrInvalidCategoryDescription},
+		{"Negative book count", NewCategoryData{Name: "Fantasy", BookCount: -1}, ErrNegative},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			category, err := NewCategory(tc.data)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, Category{}, category)
+		})
+	}
+}
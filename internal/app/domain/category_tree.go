@@ -0,0 +1,46 @@
+package domain
+
+import "fmt"
+
+// CategoryNode is a category with its subcategories.
+type CategoryNode struct {
+	category Category
+	children []CategoryNode
+}
+
+// NewCategoryTree nests the categories under their parents, keeping the order of the categories among siblings.
+// Every category has to be reachable from a root, a missing parent or a cycle fails with ErrCategoryCycle.
+func NewCategoryTree(categories []Category) ([]CategoryNode, error) {
+	children := make(map[int][]Category, len(categories))
+	for _, category := range categories {
+		children[category.ParentID()] = append(children[category.ParentID()], category)
+	}
+
+	visited := 0
+	var nest func(parentID int) []CategoryNode
+	nest = func(parentID int) []CategoryNode {
+		nodes := make([]CategoryNode, 0, len(children[parentID]))
+		for _, category := range children[parentID] {
+			visited++
+			nodes = append(nodes, CategoryNode{category: category, children: nest(category.ID())})
+		}
+		return nodes
+	}
+
+	roots := nest(0)
+	if visited != len(categories) {
+		return nil, fmt.Errorf("%w: %d categories aren't reachable from a root", ErrCategoryCycle, len(categories)-visited)
+	}
+
+	return roots, nil
+}
+
+// Category returns the category of the node.
+func (n CategoryNode) Category() Category {
+	return n.category
+}
+
+// Children returns the subcategories of the category.
+func (n CategoryNode) Children() []CategoryNode {
+	return n.children
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCategory(t *testing.T, id, parentID int) Category {
+	t.Helper()
+
+	category, err := NewCategory(NewCategoryData{ID: id, Name: "Category", ParentID: parentID})
+	require.NoError(t, err)
+
+	return category
+}
+
+func TestNewCategory_OwnParent(t *testing.T) {
+	// Act
+	category, err := NewCategory(NewCategoryData{ID: 3, Name: "Fantasy", ParentID: 3})
+
+	// Assert
+	require.ErrorIs(t, err, ErrCategoryCycle)
+	assert.Equal(t, Category{}, category)
+}
+
+func TestNewCategoryTree_NestsChildren(t *testing.T) {
+	// Arrange
+	categories := []Category{
+		newTestCategory(t, 1, 0),
+		newTestCategory(t, 2, 1),
+		newTestCategory(t, 3, 0),
+		newTestCategory(t, 4, 2),
+		newTestCategory(t, 5, 1),
+	}
+
+	// Act
+	tree, err := NewCategoryTree(categories)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, tree, 2)
+	assert.Equal(t, 1, tree[0].Category().ID())
+	assert.Equal(t, 3, tree[1].Category().ID())
+	assert.Empty(t, tree[1].Children())
+
+	require.Len(t, tree[0].Children(), 2)
+	assert.Equal(t, 2, tree[0].Children()[0].Category().ID())
+	assert.Equal(t, 5, tree[0].Children()[1].Category().ID())
+	require.Len(t, tree[0].Children()[0].Children(), 1)
+	assert.Equal(t, 4, tree[0].Children()[0].Children()[0].Category().ID())
+}
+
+func TestNewCategoryTree_Unreachable(t *testing.T) {
+	testCases := []struct {
+		name       string
+		categories []Category
+	}{
+		{"Cycle", []Category{newTestCategory(t, 1, 0), newTestCategory(t, 2, 3), newTestCategory(t, 3, 2)}},
+		{"Missing parent", []Category{newTestCategory(t, 1, 0), newTestCategory(t, 2, 9)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			tree, err := NewCategoryTree(tc.categories)
+
+			// Assert
+			require.ErrorIs(t, err, ErrCategoryCycle)
+			assert.Nil(t, tree)
+		})
+	}
+}
@@ -9,8 +9,48 @@ var (
 	ErrNegative         = errors.New("negative value")
 	ErrInvalidUserID    = errors.New("invalid user ID")
 	ErrInvalidBookIDs   = errors.New("invalid book IDs")
+	ErrInvalidQuantity  = errors.New("invalid quantity")
 	ErrNoUserInContext  = errors.New("no user in context")
 	ErrMissingMetadata  = errors.New("missing grpc metadata")
 	ErrMissingUserID    = errors.New("missing user-id in metadata")
 	ErrInvalidUserEmail = errors.New("invalid user-email in metadata")
+
+	ErrInvalidOrderStatus      = errors.New("invalid order status")
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+
+	ErrPaymentDeclined = errors.New("payment declined")
+	ErrPaymentTimeout  = errors.New("payment timed out")
+	ErrPaymentNotFound = errors.New("payment not found")
+
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+
+	ErrInvalidCartToken = errors.New("invalid cart token")
+
+	ErrInvalidWishlistName = errors.New("invalid wishlist name")
+
+	ErrInvalidMovementKind   = errors.New("invalid inventory movement kind")
+	ErrInvalidMovementReason = errors.New("invalid inventory movement reason")
+
+	ErrInvalidSearchQuery   = errors.New("invalid search query")
+	ErrInvalidSuggestPrefix = errors.New("invalid suggest prefix")
+	ErrInvalidLimit         = errors.New("invalid limit")
+	ErrInvalidBookFilter    = errors.New("invalid book filter")
+	ErrInvalidAuthorFilter  = errors.New("invalid author filter")
+	ErrInvalidYearRange     = errors.New("invalid year range")
+	ErrInvalidPriceRange    = errors.New("invalid price range")
+	ErrInvalidBookSort      = errors.New("invalid book sort")
+
+	ErrCategoryCycle          = errors.New("category cycle")
+	ErrParentCategoryNotFound = errors.New("parent category not found")
+	ErrInvalidDeletePolicy    = errors.New("invalid category delete policy")
+	ErrCategoryNotEmpty       = errors.New("category not empty")
+	ErrReassignTargetNotFound = errors.New("reassign target category not found")
+
+	ErrInvalidCategorySlug        = errors.New("invalid category slug")
+	ErrCategorySlugTaken          = errors.New("category slug taken")
+	ErrInvalidCategoryDescription = errors.New("invalid category description")
+
+	ErrInvalidPageRequest = errors.New("invalid page request")
+	ErrInvalidPageSize    = errors.New("invalid page size")
+	ErrInvalidCursor      = errors.New("invalid cursor")
 )
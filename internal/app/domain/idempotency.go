@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxIdempotencyKeyLength is the longest Idempotency-Key a client may send.
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyKey remembers a request sent with an Idempotency-Key,
+// so that a retry of the same request returns the original response instead of being applied twice.
+type IdempotencyKey struct {
+	key         string
+	userID      int
+	requestHash string
+	statusCode  int
+	response    []byte
+	createdAt   time.Time
+	completedAt time.Time
+}
+
+type NewIdempotencyKeyData struct {
+	Key         string
+	UserID      int
+	RequestHash string
+	StatusCode  int
+	Response    []byte
+	CreatedAt   time.Time
+	CompletedAt time.Time
+}
+
+// NewIdempotencyKey constructs an IdempotencyKey from the provided data.
+func NewIdempotencyKey(data NewIdempotencyKeyData) (IdempotencyKey, error) {
+	if data.Key == "" {
+		return IdempotencyKey{}, fmt.Errorf("%w: idempotency key", ErrRequired)
+	}
+	if len(data.Key) > MaxIdempotencyKeyLength {
+		return IdempotencyKey{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidIdempotencyKey, MaxIdempotencyKeyLength)
+	}
+	if data.UserID == 0 {
+		return IdempotencyKey{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	if data.RequestHash == "" {
+		return IdempotencyKey{}, fmt.Errorf("%w: request hash", ErrRequired)
+	}
+
+	return IdempotencyKey{
+		key:         data.Key,
+		userID:      data.UserID,
+		requestHash: data.RequestHash,
+		statusCode:  data.StatusCode,
+		response:    data.Response,
+		createdAt:   data.CreatedAt,
+		completedAt: data.CompletedAt,
+	}, nil
+}
+
+// Key returns the key sent by the client.
+func (k IdempotencyKey) Key() string {
+	return k.key
+}
+
+// UserID returns the identifier of the user who sent the request.
+func (k IdempotencyKey) UserID() int {
+	return k.userID
+}
+
+// RequestHash returns the hash of the request the key was first used for.
+func (k IdempotencyKey) RequestHash() string {
+	return k.requestHash
+}
+
+// StatusCode returns the status of the stored response.
+func (k IdempotencyKey) StatusCode() int {
+	return k.statusCode
+}
+
+// Response returns the stored response body.
+func (k IdempotencyKey) Response() []byte {
+	return k.response
+}
+
+// CreatedAt returns the time the key was first used.
+func (k IdempotencyKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+// CompletedAt returns the time the response was stored.
+func (k IdempotencyKey) CompletedAt() time.Time {
+	return k.completedAt
+}
+
+// Completed reports whether the response of the first request is stored,
+// a key that is not completed belongs to a request that is still running.
+func (k IdempotencyKey) Completed() bool {
+	return !k.completedAt.IsZero()
+}
+
+// Matches reports whether a retried request is the same as the one the key was first used for.
+func (k IdempotencyKey) Matches(requestHash string) bool {
+	return k.requestHash == requestHash
+}
+
+// Complete stores the response of the request.
+func (k *IdempotencyKey) Complete(statusCode int, response []byte, at time.Time) {
+	k.statusCode = statusCode
+	k.response = response
+	k.completedAt = at
+}
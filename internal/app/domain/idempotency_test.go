@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdempotencyKey_Validation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewIdempotencyKeyData
+		expectedErr error
+	}{
+		{"Empty key", NewIdempotencyKeyData{UserID: 7, RequestHash: "hash"}, ErrRequired},
+		{"Too long key", NewIdempotencyKeyData{Key: strings.Repeat("k", MaxIdempotencyKeyLength+1), UserID: 7, RequestHash: "hash"}, ErrInvalidIdempotencyKey},
+		{"Zero user", NewIdempotencyKeyData{Key: "key", RequestHash: "hash"}, ErrInvalidUserID},
+		{"Empty request hash", NewIdempotencyKeyData{Key: "key", UserID: 7}, ErrRequired},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			key, err := NewIdempotencyKey(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, IdempotencyKey{}, key)
+		})
+	}
+}
+
+func TestIdempotencyKey_Complete(t *testing.T) {
+	// Arrange
+	key, err := NewIdempotencyKey(NewIdempotencyKeyData{Key: "key", UserID: 7, RequestHash: "hash"})
+	require.NoError(t, err)
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	key.Complete(200, []byte(`{"ok":true}`), now)
+
+	// Assert
+	assert.True(t, key.Completed())
+	assert.Equal(t, 200, key.StatusCode())
+	assert.Equal(t, []byte(`{"ok":true}`), key.Response())
+	assert.Equal(t, now, key.CompletedAt())
+	assert.True(t, key.Matches("hash"))
+	assert.False(t, key.Matches("other"))
+}
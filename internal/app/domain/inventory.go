@@ -0,0 +1,295 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxMovementReasonLength is the longest reason a stock movement may have.
+const MaxMovementReasonLength = 255
+
+// MovementKind is the reason the stock of a book changed.
+type MovementKind string
+
+const (
+	// MovementRestock adds received copies to the stock
+	MovementRestock MovementKind = "restock"
+	// MovementReservation takes copies put in a cart from the stock
+	MovementReservation MovementKind = "reservation"
+	// MovementRelease puts reserved or sold copies back in stock
+	MovementRelease MovementKind = "release"
+	// MovementSale takes bought copies from the stock
+	MovementSale MovementKind = "sale"
+	// MovementAdjustment corrects the stock either way
+	MovementAdjustment MovementKind = "adjustment"
+)
+
+// Valid reports whether the kind is known.
+func (k MovementKind) Valid() bool {
+	switch k {
+	case MovementRestock, MovementReservation, MovementRelease, MovementSale, MovementAdjustment:
+		return true
+	}
+	return false
+}
+
+// Outgoing reports whether the movements of the kind take copies from the stock.
+func (k MovementKind) Outgoing() bool {
+	return k == MovementReservation || k == MovementSale
+}
+
+// InventoryMovement is an entry of the append-only inventory ledger, the stock of a book
+// is the sum of the quantities of its movements.
+type InventoryMovement struct {
+	id        int
+	bookID    int
+	kind      MovementKind
+	quantity  int
+	reason    string
+	actorID   int
+	createdAt time.Time
+}
+
+type NewInventoryMovementData struct {
+	ID     int
+	BookID int
+	Kind   MovementKind
+	// Quantity is the signed change of the stock: negative for reservations and sales,
+	// positive for restocks and releases and either for adjustments
+	Quantity int
+	Reason   string
+	// ActorID is the user who caused the movement, zero for the system
+	ActorID   int
+	CreatedAt time.Time
+}
+
+// NewInventoryMovement constructs an InventoryMovement from the provided data.
+func NewInventoryMovement(data NewInventoryMovementData) (InventoryMovement, error) {
+	if data.BookID <= 0 {
+		return InventoryMovement{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+	if !data.Kind.Valid() {
+		return InventoryMovement{}, fmt.Errorf("%w: %q", ErrInvalidMovementKind, data.Kind)
+	}
+	if data.Quantity == 0 {
+		return InventoryMovement{}, fmt.Errorf("%w: quantity can't be zero", ErrInvalidQuantity)
+	}
+	if data.Kind != MovementAdjustment && (data.Quantity < 0) != data.Kind.Outgoing() {
+		return InventoryMovement{}, fmt.Errorf("%w: %d for a %s", ErrInvalidQuantity, data.Quantity, data.Kind)
+	}
+	reason := strings.TrimSpace(data.Reason)
+	if len(reason) > MaxMovementReasonLength {
+		return InventoryMovement{}, fmt.Errorf("%w: longer than %d characters", ErrInvalidMovementReason, MaxMovementReasonLength)
+	}
+	if data.ActorID < 0 {
+		return InventoryMovement{}, fmt.Errorf("%w: actor_id", ErrInvalidUserID)
+	}
+
+	return InventoryMovement{
+		id:        data.ID,
+		bookID:    data.BookID,
+		kind:      data.Kind,
+		quantity:  data.Quantity,
+		reason:    reason,
+		actorID:   data.ActorID,
+		createdAt: data.CreatedAt,
+	}, nil
+}
+
+// ID returns the movement identifier.
+func (m InventoryMovement) ID() int {
+	return m.id
+}
+
+// BookID returns the identifier of the book whose stock changed.
+func (m InventoryMovement) BookID() int {
+	return m.bookID
+}
+
+// Kind returns why the stock changed.
+func (m InventoryMovement) Kind() MovementKind {
+	return m.kind
+}
+
+// Quantity returns the signed change of the stock.
+func (m InventoryMovement) Quantity() int {
+	return m.quantity
+}
+
+// Reason returns the free-form explanation of the movement.
+func (m InventoryMovement) Reason() string {
+	return m.reason
+}
+
+// ActorID returns the identifier of the user who caused the movement, zero for the system.
+func (m InventoryMovement) ActorID() int {
+	return m.actorID
+}
+
+// CreatedAt returns when the movement was recorded.
+func (m InventoryMovement) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// Inventory is the stock of a book next to the stock derived from its ledger.
+type Inventory struct {
+	bookID      int
+	stock       int
+	ledgerStock int
+	movements   []InventoryMovement
+}
+
+type NewInventoryData struct {
+	BookID      int
+	Stock       int
+	LedgerStock int
+	// Movements are the latest movements of the book, newest first
+	Movements []InventoryMovement
+}
+
+// NewInventory constructs an Inventory from the provided data.
+func NewInventory(data NewInventoryData) (Inventory, error) {
+	if data.BookID <= 0 {
+		return Inventory{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+
+	return Inventory{
+		bookID:      data.BookID,
+		stock:       data.Stock,
+		ledgerStock: data.LedgerStock,
+		movements:   data.Movements,
+	}, nil
+}
+
+// BookID returns the identifier of the book.
+func (i Inventory) BookID() int {
+	return i.bookID
+}
+
+// Stock returns the stock stored with the book.
+func (i Inventory) Stock() int {
+	return i.stock
+}
+
+// LedgerStock returns the sum of all movements of the book.
+func (i Inventory) LedgerStock() int {
+	return i.ledgerStock
+}
+
+// Consistent reports whether the stored stock matches the ledger.
+func (i Inventory) Consistent() bool {
+	return i.stock == i.ledgerStock
+}
+
+// Movements returns the latest movements, newest first.
+func (i Inventory) Movements() []InventoryMovement {
+	return i.movements
+}
+
+// StockReconciliation compares the stock of a book with the stock expected from its restocks,
+// the copies reserved in carts and the copies sold.
+type StockReconciliation struct {
+	bookID    int
+	title     string
+	stock     int
+	restocked int
+	reserved  int
+	sold      int
+	fixed     bool
+}
+
+type NewStockReconciliationData struct {
+	BookID int
+	Title  string
+	Stock  int
+	// Restocked is the number of copies that were ever put in stock
+	Restocked int
+	// Reserved is the number of copies in active carts
+	Reserved int
+	// Sold is the number of copies in orders that weren't cancelled or refunded before they shipped
+	Sold int
+	// Fixed tells whether the stock was corrected to the expected one
+	Fixed bool
+}
+
+// NewStockReconciliation constructs a StockReconciliation from the provided data.
+func NewStockReconciliation(data NewStockReconciliationData) (StockReconciliation, error) {
+	if data.BookID <= 0 {
+		return StockReconciliation{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+	if data.Restocked < 0 {
+		return StockReconciliation{}, fmt.Errorf("%w: restocked", ErrNegative)
+	}
+	if data.Reserved < 0 {
+		return StockReconciliation{}, fmt.Errorf("%w: reserved", ErrNegative)
+	}
+	if data.Sold < 0 {
+		return StockReconciliation{}, fmt.Errorf("%w: sold", ErrNegative)
+	}
+
+	return StockReconciliation{
+		bookID:    data.BookID,
+		title:     data.Title,
+		stock:     data.Stock,
+		restocked: data.Restocked,
+		reserved:  data.Reserved,
+		sold:      data.Sold,
+		fixed:     data.Fixed,
+	}, nil
+}
+
+// BookID returns the identifier of the book.
+func (r StockReconciliation) BookID() int {
+	return r.bookID
+}
+
+// Title returns the title of the book.
+func (r StockReconciliation) Title() string {
+	return r.title
+}
+
+// Stock returns the stock stored with the book.
+func (r StockReconciliation) Stock() int {
+	return r.stock
+}
+
+// Restocked returns the number of copies that were ever put in stock.
+func (r StockReconciliation) Restocked() int {
+	return r.restocked
+}
+
+// Reserved returns the number of copies in active carts.
+func (r StockReconciliation) Reserved() int {
+	return r.reserved
+}
+
+// Sold returns the number of copies in orders that weren't cancelled or refunded before they shipped.
+func (r StockReconciliation) Sold() int {
+	return r.sold
+}
+
+// Expected returns the available stock derived from the restocks, reservations and sales.
+func (r StockReconciliation) Expected() int {
+	return r.restocked - r.reserved - r.sold
+}
+
+// Difference returns how many copies the stock has over the expected one, negative when it has fewer.
+func (r StockReconciliation) Difference() int {
+	return r.stock - r.Expected()
+}
+
+// Consistent reports whether the stock matches the expected one.
+func (r StockReconciliation) Consistent() bool {
+	return r.Difference() == 0
+}
+
+// Fixable reports whether the stock can be corrected to the expected one, it can't go negative.
+func (r StockReconciliation) Fixable() bool {
+	return !r.Consistent() && r.Expected() >= 0
+}
+
+// Fixed reports whether the stock was corrected to the expected one.
+func (r StockReconciliation) Fixed() bool {
+	return r.fixed
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInventoryMovement_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewInventoryMovementData
+		expectedErr error
+	}{
+		{"Zero book ID", NewInventoryMovementData{Kind: MovementRestock, Quantity: 1}, ErrNegative},
+		{"Unknown kind", NewInventoryMovementData{BookID: 1, Kind: "theft", Quantity: -1}, ErrInvalidMovementKind},
+		{"Zero quantity", NewInventoryMovementData{BookID: 1, Kind: MovementAdjustment}, ErrInvalidQuantity},
+		{"Negative restock", NewInventoryMovementData{BookID: 1, Kind: MovementRestock, Quantity: -5}, ErrInvalidQuantity},
+		{"Positive sale", NewInventoryMovementData{BookID: 1, Kind: MovementSale, Quantity: 1}, ErrInvalidQuantity},
+		{"Long reason", NewInventoryMovementData{BookID: 1, Kind: MovementRestock, Quantity: 1, Reason: strings.Repeat("a", MaxMovementReasonLength+1)}, ErrInvalidMovementReason},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			movement, err := NewInventoryMovement(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, InventoryMovement{}, movement)
+		})
+	}
+}
+
+func TestNewInventoryMovement_AdjustmentEitherWay(t *testing.T) {
+	for _, quantity := range []int{-3, 3} {
+		// Act
+		movement, err := NewInventoryMovement(NewInventoryMovementData{BookID: 1, Kind: MovementAdjustment, Quantity: quantity, Reason: " recount "})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, quantity, movement.Quantity())
+		assert.Equal(t, "recount", movement.Reason())
+	}
+}
+
+func TestInventory_Consistent(t *testing.T) {
+	// Arrange
+	consistent, err := NewInventory(NewInventoryData{BookID: 1, Stock: 7, LedgerStock: 7})
+	require.NoError(t, err)
+	drifted, err := NewInventory(NewInventoryData{BookID: 1, Stock: 7, LedgerStock: 6})
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, consistent.Consistent())
+	assert.False(t, drifted.Consistent())
+}
+
+func TestNewStockReconciliation_NegativeCounts(t *testing.T) {
+	testCases := []struct {
+		name string
+		data NewStockReconciliationData
+	}{
+		{"Zero book ID", NewStockReconciliationData{Stock: 1, Restocked: 1}},
+		{"Negative restocked", NewStockReconciliationData{BookID: 1, Restocked: -1}},
+		{"Negative reserved", NewStockReconciliationData{BookID: 1, Reserved: -1}},
+		{"Negative sold", NewStockReconciliationData{BookID: 1, Sold: -1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			reconciliation, err := NewStockReconciliation(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrNegative)
+			assert.Equal(t, StockReconciliation{}, reconciliation)
+		})
+	}
+}
+
+func TestStockReconciliation_Expected(t *testing.T) {
+	testCases := []struct {
+		name               string
+		data               NewStockReconciliationData
+		expectedStock      int
+		expectedDifference int
+		expectedFixable    bool
+	}{
+		{"Consistent", NewStockReconciliationData{BookID: 1, Stock: 5, Restocked: 10, Reserved: 2, Sold: 3}, 5, 0, false},
+		{"Stock over", NewStockReconciliationData{BookID: 1, Stock: 7, Restocked: 10, Reserved: 2, Sold: 3}, 5, 2, true},
+		{"Stock under", NewStockReconciliationData{BookID: 1, Stock: 4, Restocked: 10, Reserved: 2, Sold: 3}, 5, -1, true},
+		{"Oversold", NewStockReconciliationData{BookID: 1, Stock: 0, Restocked: 2, Reserved: 1, Sold: 3}, -2, 2, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			reconciliation, err := NewStockReconciliation(tc.data)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStock, reconciliation.Expected())
+			assert.Equal(t, tc.expectedDifference, reconciliation.Difference())
+			assert.Equal(t, tc.expectedDifference == 0, reconciliation.Consistent())
+			assert.Equal(t, tc.expectedFixable, reconciliation.Fixable())
+		})
+	}
+}
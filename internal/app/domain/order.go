@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderItem is a snapshot of a book at the moment it was bought.
+type OrderItem struct {
+	bookID   int
+	title    string
+	price    int
+	quantity int
+}
+
+// NewOrderItemData describes a bought book, a zero Quantity means one copy.
+type NewOrderItemData struct {
+	BookID   int
+	Title    string
+	Price    int
+	Quantity int
+}
+
+// Order is a completed purchase of the books that were in a cart.
+type Order struct {
+	id        int
+	userID    int
+	items     []OrderItem
+	total     int
+	status    OrderStatus
+	paymentID string
+	// refundedAt is zero until the money of a cancelled or refunded order is returned
+	refundedAt time.Time
+	history    []OrderTransition
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+type NewOrderData struct {
+	ID         int
+	UserID     int
+	Items      []NewOrderItemData
+	Status     OrderStatus
+	PaymentID  string
+	RefundedAt time.Time
+	History    []NewOrderTransitionData
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewOrder constructs an Order from the provided data, the total is calculated from the items.
+func NewOrder(data NewOrderData) (Order, error) {
+	if err := validateOrderData(data); err != nil {
+		return Order{}, fmt.Errorf("faild order data validation: %w", err)
+	}
+
+	items := make([]OrderItem, 0, len(data.Items))
+	var total int
+	for _, item := range data.Items {
+		quantity := max(item.Quantity, 1)
+		items = append(items, OrderItem{
+			bookID:   item.BookID,
+			title:    item.Title,
+			price:    item.Price,
+			quantity: quantity,
+		})
+		total += item.Price * quantity
+	}
+
+	history := make([]OrderTransition, 0, len(data.History))
+	for _, transitionData := range data.History {
+		transition, err := NewOrderTransition(transitionData)
+		if err != nil {
+			return Order{}, fmt.Errorf("faild order history validation: %w", err)
+		}
+		history = append(history, transition)
+	}
+
+	return Order{
+		id:         data.ID,
+		userID:     data.UserID,
+		items:      items,
+		total:      total,
+		status:     data.Status,
+		paymentID:  data.PaymentID,
+		refundedAt: data.RefundedAt,
+		history:    history,
+		createdAt:  data.CreatedAt,
+		updatedAt:  data.UpdatedAt,
+	}, nil
+}
+
+func validateOrderData(data NewOrderData) error {
+	if data.UserID == 0 {
+		return fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	if len(data.Items) == 0 {
+		return fmt.Errorf("%w: items", ErrNil)
+	}
+	if data.Status == "" {
+		return fmt.Errorf("%w: status", ErrRequired)
+	}
+	if !data.Status.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidOrderStatus, data.Status)
+	}
+	for _, item := range data.Items {
+		// book_id is zero when the book was deleted after the purchase
+		if item.BookID < 0 {
+			return fmt.Errorf("%w: book_id", ErrNegative)
+		}
+		if item.Title == "" {
+			return fmt.Errorf("%w: title", ErrRequired)
+		}
+		if item.Price <= 0 {
+			return fmt.Errorf("%w: price", ErrNegative)
+		}
+		if item.Quantity < 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidQuantity, item.Quantity)
+		}
+	}
+	return nil
+}
+
+// ID returns the order identifier.
+func (o Order) ID() int {
+	return o.id
+}
+
+// UserID returns the identifier of the user who placed the order.
+func (o Order) UserID() int {
+	return o.userID
+}
+
+// Items returns the bought books.
+func (o Order) Items() []OrderItem {
+	return o.items
+}
+
+// Total returns the sum of the item prices multiplied by their quantities.
+func (o Order) Total() int {
+	return o.total
+}
+
+// Status returns the order status.
+func (o Order) Status() OrderStatus {
+	return o.status
+}
+
+// PaymentID returns the payment provider reference, it is empty until the order is paid.
+func (o Order) PaymentID() string {
+	return o.paymentID
+}
+
+// RefundedAt returns the time the money of the order was returned, zero when it wasn't.
+func (o Order) RefundedAt() time.Time {
+	return o.refundedAt
+}
+
+// History returns the status changes of the order, oldest first.
+func (o Order) History() []OrderTransition {
+	return o.history
+}
+
+// CreatedAt returns the time the order was placed.
+func (o Order) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+// UpdatedAt returns the time the order was last changed.
+func (o Order) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+// CanBeViewedBy reports whether the user is allowed to see the order.
+// Customers see only their own orders, admins see everyone's.
+func (o Order) CanBeViewedBy(user User) bool {
+	return user.Admin() || user.ID() == o.userID
+}
+
+// ChangeStatus moves the order to the next status on behalf of the actor
+// and records the transition in the order history.
+func (o *Order) ChangeStatus(next OrderStatus, actorID int, at time.Time) error {
+	if !next.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidOrderStatus, next)
+	}
+	if !o.status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: from %q to %q", ErrInvalidStatusTransition, o.status, next)
+	}
+
+	transition, err := NewOrderTransition(NewOrderTransitionData{
+		From:      o.status,
+		To:        next,
+		ActorID:   actorID,
+		CreatedAt: at,
+	})
+	if err != nil {
+		return err
+	}
+
+	o.status = next
+	o.history = append(o.history, transition)
+	o.updatedAt = at
+
+	return nil
+}
+
+// MarkPaid records the captured payment and moves the order to paid.
+func (o *Order) MarkPaid(paymentID string, actorID int, at time.Time) error {
+	if paymentID == "" {
+		return fmt.Errorf("%w: payment_id", ErrRequired)
+	}
+	if err := o.ChangeStatus(OrderStatusPaid, actorID, at); err != nil {
+		return err
+	}
+	o.paymentID = paymentID
+	return nil
+}
+
+// NeedsRefund reports whether the money of a paid order has to be returned,
+// which is the case once it is cancelled or refunded until the refund is recorded.
+func (o Order) NeedsRefund() bool {
+	if o.paymentID == "" || !o.refundedAt.IsZero() {
+		return false
+	}
+	return o.status == OrderStatusCancelled || o.status == OrderStatusRefunded
+}
+
+// MarkRefunded records that the money of a cancelled or refunded order was returned.
+func (o *Order) MarkRefunded(at time.Time) error {
+	if !o.refundedAt.IsZero() {
+		return nil
+	}
+	if !o.NeedsRefund() {
+		return fmt.Errorf("%w: order %d has nothing to refund", ErrInvalidStatusTransition, o.id)
+	}
+	o.refundedAt = at
+	o.updatedAt = at
+	return nil
+}
+
+// Cancel cancels an order that has not been shipped yet.
+// Customers can cancel only their own orders, admins can cancel any order.
+func (o *Order) Cancel(actor User, at time.Time) error {
+	if !o.CanBeViewedBy(actor) {
+		return ErrNotFound
+	}
+	return o.ChangeStatus(OrderStatusCancelled, actor.ID(), at)
+}
+
+// BookID returns the identifier of the bought book.
+func (i OrderItem) BookID() int {
+	return i.bookID
+}
+
+// Title returns the book title at the time of purchase.
+func (i OrderItem) Title() string {
+	return i.title
+}
+
+// Price returns the book price at the time of purchase.
+func (i OrderItem) Price() int {
+	return i.price
+}
+
+// Quantity returns the number of bought copies.
+func (i OrderItem) Quantity() int {
+	return i.quantity
+}
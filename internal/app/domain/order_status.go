@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderStatus is the lifecycle state of an order.
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusPaid      OrderStatus = "paid"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusDelivered OrderStatus = "delivered"
+	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusRefunded  OrderStatus = "refunded"
+)
+
+// orderTransitions lists the statuses an order can move to from each status.
+// Cancelled and refunded orders are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:   {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:      {OrderStatusShipped, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusShipped:   {OrderStatusDelivered},
+	OrderStatusDelivered: {OrderStatusRefunded},
+	OrderStatusCancelled: {},
+	OrderStatusRefunded:  {},
+}
+
+// IsValid reports whether the status is known.
+func (s OrderStatus) IsValid() bool {
+	_, ok := orderTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether an order in this status can be moved to the next one.
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Restocks reports whether moving an order from this status to next puts its books back in stock.
+// Cancelled orders and orders refunded before they shipped return their books, a delivered order
+// that is refunded doesn't, its books are with the customer.
+func (s OrderStatus) Restocks(next OrderStatus) bool {
+	if s == next {
+		return false
+	}
+	return next == OrderStatusCancelled || (s == OrderStatusPaid && next == OrderStatusRefunded)
+}
+
+// OrderTransition is a status change of an order made by a user.
+type OrderTransition struct {
+	from      OrderStatus
+	to        OrderStatus
+	actorID   int
+	createdAt time.Time
+}
+
+type NewOrderTransitionData struct {
+	From      OrderStatus
+	To        OrderStatus
+	ActorID   int
+	CreatedAt time.Time
+}
+
+// NewOrderTransition constructs an OrderTransition from the provided data.
+func NewOrderTransition(data NewOrderTransitionData) (OrderTransition, error) {
+	if !data.From.IsValid() {
+		return OrderTransition{}, fmt.Errorf("%w: %q", ErrInvalidOrderStatus, data.From)
+	}
+	if !data.To.IsValid() {
+		return OrderTransition{}, fmt.Errorf("%w: %q", ErrInvalidOrderStatus, data.To)
+	}
+	if data.ActorID == 0 {
+		return OrderTransition{}, fmt.Errorf("%w: actor_id", ErrInvalidUserID)
+	}
+	return OrderTransition{
+		from:      data.From,
+		to:        data.To,
+		actorID:   data.ActorID,
+		createdAt: data.CreatedAt,
+	}, nil
+}
+
+// From returns the status before the transition.
+func (t OrderTransition) From() OrderStatus {
+	return t.from
+}
+
+// To returns the status after the transition.
+func (t OrderTransition) To() OrderStatus {
+	return t.to
+}
+
+// ActorID returns the identifier of the user who changed the status.
+func (t OrderTransition) ActorID() int {
+	return t.actorID
+}
+
+// CreatedAt returns the time of the transition.
+func (t OrderTransition) CreatedAt() time.Time {
+	return t.createdAt
+}
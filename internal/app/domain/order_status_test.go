@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOrder(t *testing.T, status OrderStatus) Order {
+	order, err := NewOrder(NewOrderData{
+		ID:     1,
+		UserID: 7,
+		Items:  []NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000}},
+		Status: status,
+	})
+	require.NoError(t, err)
+	return order
+}
+
+// Test the happy path of the order lifecycle
+func TestOrder_ChangeStatus_FullLifecycle(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPending)
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	for _, next := range []OrderStatus{OrderStatusPaid, OrderStatusShipped, OrderStatusDelivered, OrderStatusRefunded} {
+		require.NoError(t, order.ChangeStatus(next, 1, now))
+	}
+
+	// Assert
+	assert.Equal(t, OrderStatusRefunded, order.Status())
+	assert.Equal(t, now, order.UpdatedAt())
+	require.Len(t, order.History(), 4)
+	assert.Equal(t, OrderStatusPending, order.History()[0].From())
+	assert.Equal(t, OrderStatusPaid, order.History()[0].To())
+	assert.Equal(t, 1, order.History()[0].ActorID())
+	assert.Equal(t, now, order.History()[0].CreatedAt())
+}
+
+// Test business rule: only allowed transitions are accepted
+func TestOrder_ChangeStatus_InvalidTransition(t *testing.T) {
+	testCases := []struct {
+		name string
+		from OrderStatus
+		to   OrderStatus
+	}{
+		{"Ship unpaid order", OrderStatusPending, OrderStatusShipped},
+		{"Cancel shipped order", OrderStatusShipped, OrderStatusCancelled},
+		{"Reopen cancelled order", OrderStatusCancelled, OrderStatusPaid},
+		{"Refund twice", OrderStatusRefunded, OrderStatusRefunded},
+		{"Deliver before shipping", OrderStatusPaid, OrderStatusDelivered},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			order := newTestOrder(t, tc.from)
+
+			// Act
+			err := order.ChangeStatus(tc.to, 1, time.Now())
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+			assert.Equal(t, tc.from, order.Status())
+			assert.Empty(t, order.History())
+		})
+	}
+}
+
+// Test business rule: only the books of orders that never shipped go back in stock
+func TestOrderStatus_Restocks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     OrderStatus
+		to       OrderStatus
+		expected bool
+	}{
+		{"Cancel pending order", OrderStatusPending, OrderStatusCancelled, true},
+		{"Cancel paid order", OrderStatusPaid, OrderStatusCancelled, true},
+		{"Refund paid order", OrderStatusPaid, OrderStatusRefunded, true},
+		{"Refund delivered order", OrderStatusDelivered, OrderStatusRefunded, false},
+		{"Ship paid order", OrderStatusPaid, OrderStatusShipped, false},
+		{"Keep cancelled order", OrderStatusCancelled, OrderStatusCancelled, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			restocks := tc.from.Restocks(tc.to)
+
+			// Assert
+			assert.Equal(t, tc.expected, restocks)
+		})
+	}
+}
+
+// Test business rule: unknown statuses are rejected
+func TestOrder_ChangeStatus_UnknownStatus(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPaid)
+
+	// Act
+	err := order.ChangeStatus("lost", 1, time.Now())
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidOrderStatus)
+	assert.Equal(t, OrderStatusPaid, order.Status())
+}
+
+// Test business rule: customers can cancel only their own orders that haven't shipped
+func TestOrder_Cancel(t *testing.T) {
+	owner, err := NewUserFromToken(NewUserData{ID: 7, Email: "owner@example.com"})
+	require.NoError(t, err)
+	stranger, err := NewUserFromToken(NewUserData{ID: 8, Email: "other@example.com"})
+	require.NoError(t, err)
+	admin, err := NewUserFromToken(NewUserData{ID: 1, Email: "admin@example.com", Admin: true})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		status      OrderStatus
+		actor       User
+		expectedErr error
+	}{
+		{"Owner cancels paid order", OrderStatusPaid, owner, nil},
+		{"Admin cancels pending order", OrderStatusPending, admin, nil},
+		{"Stranger cannot see the order", OrderStatusPaid, stranger, ErrNotFound},
+		{"Shipped order cannot be cancelled", OrderStatusShipped, owner, ErrInvalidStatusTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			order := newTestOrder(t, tc.status)
+
+			// Act
+			err := order.Cancel(tc.actor, time.Now())
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Equal(t, tc.status, order.Status())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, OrderStatusCancelled, order.Status())
+			assert.Equal(t, tc.actor.ID(), order.History()[0].ActorID())
+		})
+	}
+}
+
+// Test business rule: a paid order remembers its payment and is refunded once cancelled
+func TestOrder_MarkPaid_ThenCancel_NeedsRefund(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPending)
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	err := order.MarkPaid("pay_1", 7, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, OrderStatusPaid, order.Status())
+	assert.Equal(t, "pay_1", order.PaymentID())
+	assert.False(t, order.NeedsRefund())
+
+	require.NoError(t, order.ChangeStatus(OrderStatusCancelled, 7, now))
+	assert.True(t, order.NeedsRefund())
+}
+
+func TestOrder_MarkPaid_EmptyPaymentID_ReturnsRequiredError(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPending)
+
+	// Act
+	err := order.MarkPaid("", 7, time.Now())
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRequired)
+	assert.Equal(t, OrderStatusPending, order.Status())
+	assert.Empty(t, order.PaymentID())
+}
+
+// Test business rule: an unpaid order has nothing to refund
+func TestOrder_NeedsRefund_UnpaidCancelledOrder(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPending)
+
+	// Act
+	err := order.ChangeStatus(OrderStatusCancelled, 7, time.Now())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, order.NeedsRefund())
+}
+
+// Test business rule: a recorded refund is not made again
+func TestOrder_MarkRefunded(t *testing.T) {
+	// Arrange
+	order := newTestOrder(t, OrderStatusPending)
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, order.MarkPaid("pay_1", 7, now))
+	require.ErrorIs(t, order.MarkRefunded(now), ErrInvalidStatusTransition, "a paid order has nothing to refund")
+	require.NoError(t, order.ChangeStatus(OrderStatusCancelled, 7, now))
+
+	// Act
+	err := order.MarkRefunded(now.Add(time.Minute))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Minute), order.RefundedAt())
+	assert.False(t, order.NeedsRefund())
+	assert.NoError(t, order.MarkRefunded(now.Add(time.Hour)), "recording the refund again does nothing")
+	assert.Equal(t, now.Add(time.Minute), order.RefundedAt())
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrder_ValidData_CalculatesTotal(t *testing.T) {
+	// Arrange
+	orderData := NewOrderData{
+		ID:     1,
+		UserID: 7,
+		Items: []NewOrderItemData{
+			{BookID: 1, Title: "Clean Architecture", Price: 2999},
+			{BookID: 2, Title: "Domain-Driven Design", Price: 3500, Quantity: 2},
+		},
+		Status: OrderStatusPaid,
+	}
+
+	// Act
+	order, err := NewOrder(orderData)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, order.ID())
+	assert.Equal(t, 7, order.UserID())
+	assert.Equal(t, OrderStatusPaid, order.Status())
+	assert.Equal(t, 9999, order.Total())
+	require.Len(t, order.Items(), 2)
+	assert.Equal(t, "Clean Architecture", order.Items()[0].Title())
+	assert.Equal(t, 3500, order.Items()[1].Price())
+	assert.Equal(t, 1, order.Items()[0].Quantity())
+	assert.Equal(t, 2, order.Items()[1].Quantity())
+}
+
+func TestNewOrder_NoItems_ReturnsNilError(t *testing.T) {
+	// Arrange
+	orderData := NewOrderData{
+		UserID: 7,
+		Status: OrderStatusPaid,
+	}
+
+	// Act
+	order, err := NewOrder(orderData)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNil)
+	assert.Contains(t, err.Error(), "items")
+	assert.Equal(t, Order{}, order)
+}
+
+func TestNewOrder_ZeroUserID_ReturnsInvalidUserIDError(t *testing.T) {
+	// Arrange
+	orderData := NewOrderData{
+		Items:  []NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000}},
+		Status: OrderStatusPaid,
+	}
+
+	// Act
+	order, err := NewOrder(orderData)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUserID)
+	assert.Equal(t, Order{}, order)
+}
+
+func TestNewOrder_InvalidItemPrice_ReturnsNegativeError(t *testing.T) {
+	// Arrange
+	orderData := NewOrderData{
+		UserID: 7,
+		Items:  []NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 0}},
+		Status: OrderStatusPaid,
+	}
+
+	// Act
+	order, err := NewOrder(orderData)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNegative)
+	assert.Contains(t, err.Error(), "price")
+	assert.Equal(t, Order{}, order)
+}
+
+func TestOrder_CanBeViewedBy(t *testing.T) {
+	order, err := NewOrder(NewOrderData{
+		ID:     1,
+		UserID: 7,
+		Items:  []NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000}},
+		Status: OrderStatusPaid,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		user     NewUserData
+		expected bool
+	}{
+		{"Owner", NewUserData{ID: 7, Email: "owner@example.com"}, true},
+		{"Another customer", NewUserData{ID: 8, Email: "other@example.com"}, false},
+		{"Admin", NewUserData{ID: 1, Email: "admin@example.com", Admin: true}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			user, err := NewUserFromToken(tc.user)
+			require.NoError(t, err)
+
+			// Act & Assert
+			assert.Equal(t, tc.expected, order.CanBeViewedBy(user))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// DefaultPageSize is the number of items on a page when no size is asked for.
+	DefaultPageSize = 10
+	// MaxPageSize is the largest number of items on a page.
+	MaxPageSize = 100
+)
+
+// Cursor points at the last item of a page, the next page starts right after it. It holds the sort of the listing,
+// the value the item is sorted by and its ID that breaks the ties, so rows hiding or reappearing between the pages
+// don't shift them.
+type Cursor struct {
+	sort string
+	key  string
+	id   int
+}
+
+// cursorData is the encoded form of a cursor
+type cursorData struct {
+	Sort string `json:"s"`
+	Key  string `json:"k,omitempty"`
+	ID   int    `json:"i"`
+}
+
+// NewCursor constructs a Cursor after the item with the id and the sort key of the listing sorted by sort.
+func NewCursor(sort, key string, id int) (Cursor, error) {
+	if id <= 0 {
+		return Cursor{}, fmt.Errorf("%w: id", ErrInvalidCursor)
+	}
+
+	return Cursor{
+		sort: sort,
+		key:  key,
+		id:   id,
+	}, nil
+}
+
+// DecodeCursor parses a cursor encoded by Cursor.Encode.
+func DecodeCursor(encoded string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	var data cursorData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	return NewCursor(data.Sort, data.Key, data.ID)
+}
+
+// Encode returns the opaque form of the cursor handed to the clients.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(cursorData{Sort: c.sort, Key: c.key, ID: c.id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Sort returns the sort of the listing the cursor belongs to.
+func (c Cursor) Sort() string {
+	return c.sort
+}
+
+// Key returns the value the item is sorted by, empty when the listing is sorted by ID.
+func (c Cursor) Key() string {
+	return c.key
+}
+
+// ID returns the identifier of the item.
+func (c Cursor) ID() int {
+	return c.id
+}
+
+// PageRequest asks for a page of a listing, either after a cursor or by its number.
+type PageRequest struct {
+	size      int
+	number    int
+	cursor    Cursor
+	hasCursor bool
+	withTotal bool
+}
+
+type NewPageRequestData struct {
+	// Size is the number of items on the page, DefaultPageSize when zero
+	Size int
+	// Number is the position of the page starting from 1, the first page when zero. Prefer Cursor, numbered pages
+	// shift when items are added or removed.
+	Number int
+	// Cursor is the encoded cursor of the previous page, the first page when empty
+	Cursor string
+	// WithTotal counts all items of the listing
+	WithTotal bool
+}
+
+// NewPageRequest constructs a PageRequest from the provided data.
+func NewPageRequest(data NewPageRequestData) (PageRequest, error) {
+	size := data.Size
+	if size == 0 {
+		size = DefaultPageSize
+	}
+	if size < 0 || size > MaxPageSize {
+		return PageRequest{}, fmt.Errorf("%w: from 1 to %d", ErrInvalidPageSize, MaxPageSize)
+	}
+
+	number := data.Number
+	if number == 0 {
+		number = 1
+	}
+	if number < 0 {
+		return PageRequest{}, fmt.Errorf("%w: negative page number", ErrInvalidPageRequest)
+	}
+
+	request := PageRequest{
+		size:      size,
+		number:    number,
+		withTotal: data.WithTotal,
+	}
+	if data.Cursor == "" {
+		return request, nil
+	}
+	if number > 1 {
+		return PageRequest{}, fmt.Errorf("%w: can't be combined with a page number", ErrInvalidCursor)
+	}
+
+	cursor, err := DecodeCursor(data.Cursor)
+	if err != nil {
+		return PageRequest{}, err
+	}
+	request.cursor = cursor
+	request.hasCursor = true
+
+	return request, nil
+}
+
+// Size returns the number of items on the page.
+func (p PageRequest) Size() int {
+	if p.size == 0 {
+		return DefaultPageSize
+	}
+	return p.size
+}
+
+// Offset returns the number of items before a numbered page.
+func (p PageRequest) Offset() int {
+	if p.number <= 1 {
+		return 0
+	}
+	return (p.number - 1) * p.Size()
+}
+
+// Cursor returns the cursor the page starts after, false for the first and numbered pages.
+func (p PageRequest) Cursor() (Cursor, bool) {
+	return p.cursor, p.hasCursor
+}
+
+// WithTotal reports whether all items of the listing are counted.
+func (p PageRequest) WithTotal() bool {
+	return p.withTotal
+}
+
+// Page is a part of a listing.
+type Page[T any] struct {
+	items      []T
+	nextCursor string
+	total      int
+	counted    bool
+}
+
+type NewPageData[T any] struct {
+	Items []T
+	// NextCursor is the encoded cursor of the next page, empty on the last page
+	NextCursor string
+	// Total is the number of all items of the listing when Counted
+	Total   int
+	Counted bool
+}
+
+// NewPage constructs a Page from the provided data.
+func NewPage[T any](data NewPageData[T]) (Page[T], error) {
+	if data.Total < 0 {
+		return Page[T]{}, fmt.Errorf("%w: total", ErrNegative)
+	}
+
+	return Page[T]{
+		items:      data.Items,
+		nextCursor: data.NextCursor,
+		total:      data.Total,
+		counted:    data.Counted,
+	}, nil
+}
+
+// Items returns the items on the page.
+func (p Page[T]) Items() []T {
+	return p.items
+}
+
+// NextCursor returns the encoded cursor of the next page, empty on the last page.
+func (p Page[T]) NextCursor() string {
+	return p.nextCursor
+}
+
+// Total returns the number of all items of the listing, false when they weren't counted.
+func (p Page[T]) Total() (int, bool) {
+	return p.total, p.counted
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	// Arrange
+	cursor, err := NewCursor("price_desc", "1500", 42)
+	require.NoError(t, err)
+
+	// Act
+	decoded, err := DecodeCursor(cursor.Encode())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, encoded := range []string{"not base64!", "bm90IGpzb24", "eyJzIjoiaWQiLCJpIjowfQ"} {
+		// Act
+		cursor, err := DecodeCursor(encoded)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidCursor)
+		assert.Equal(t, Cursor{}, cursor)
+	}
+}
+
+func TestNewPageRequest_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewPageRequestData
+		expectedErr error
+	}{
+		{"Negative size", NewPageRequestData{Size: -1}, ErrInvalidPageSize},
+		{"Large size", NewPageRequestData{Size: MaxPageSize + 1}, ErrInvalidPageSize},
+		{"Negative number", NewPageRequestData{Number: -1}, ErrInvalidPageRequest},
+		{"Malformed cursor", NewPageRequestData{Cursor: "abc"}, ErrInvalidCursor},
+		{"Cursor and number", NewPageRequestData{Number: 2, Cursor: "eyJzIjoiaWQiLCJpIjoxfQ"}, ErrInvalidCursor},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			request, err := NewPageRequest(tc.data)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, PageRequest{}, request)
+		})
+	}
+}
+
+func TestNewPageRequest_Defaults(t *testing.T) {
+	// Act
+	first, err := NewPageRequest(NewPageRequestData{})
+	require.NoError(t, err)
+	third, err := NewPageRequest(NewPageRequestData{Size: 20, Number: 3})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, DefaultPageSize, first.Size())
+	assert.Zero(t, first.Offset())
+	_, ok := first.Cursor()
+	assert.False(t, ok)
+	assert.Equal(t, 40, third.Offset())
+}
+
+func TestNewPageRequest_Cursor(t *testing.T) {
+	// Arrange
+	cursor, err := NewCursor("id", "", 10)
+	require.NoError(t, err)
+
+	// Act
+	request, err := NewPageRequest(NewPageRequestData{Cursor: cursor.Encode(), WithTotal: true})
+
+	// Assert
+	require.NoError(t, err)
+	after, ok := request.Cursor()
+	assert.True(t, ok)
+	assert.Equal(t, cursor, after)
+	assert.Zero(t, request.Offset())
+	assert.True(t, request.WithTotal())
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxNotificationAttempts is how many times a back-in-stock notification is sent before it is given up.
+const MaxNotificationAttempts = 5
+
+// BookSubscription asks to notify the user once the sold-out book is back in stock.
+type BookSubscription struct {
+	userID    int
+	bookID    int
+	createdAt time.Time
+}
+
+type NewBookSubscriptionData struct {
+	UserID    int
+	BookID    int
+	CreatedAt time.Time
+}
+
+// NewBookSubscription constructs a BookSubscription from the provided data.
+func NewBookSubscription(data NewBookSubscriptionData) (BookSubscription, error) {
+	if data.UserID == 0 {
+		return BookSubscription{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	if data.BookID <= 0 {
+		return BookSubscription{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+
+	return BookSubscription{
+		userID:    data.UserID,
+		bookID:    data.BookID,
+		createdAt: data.CreatedAt,
+	}, nil
+}
+
+// UserID returns the identifier of the subscribed user.
+func (s BookSubscription) UserID() int {
+	return s.userID
+}
+
+// BookID returns the identifier of the awaited book.
+func (s BookSubscription) BookID() int {
+	return s.bookID
+}
+
+// CreatedAt returns when the user subscribed.
+func (s BookSubscription) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// StockNotification is a queued message telling a subscriber that a book is back in stock.
+type StockNotification struct {
+	id        int
+	userID    int
+	email     string
+	bookID    int
+	title     string
+	attempts  int
+	createdAt time.Time
+}
+
+type NewStockNotificationData struct {
+	ID        int
+	UserID    int
+	Email     string
+	BookID    int
+	Title     string
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// NewStockNotification constructs a StockNotification from the provided data.
+func NewStockNotification(data NewStockNotificationData) (StockNotification, error) {
+	if data.UserID == 0 {
+		return StockNotification{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	if data.Email == "" {
+		return StockNotification{}, fmt.Errorf("%w: email", ErrRequired)
+	}
+	if data.BookID <= 0 {
+		return StockNotification{}, fmt.Errorf("%w: book_id", ErrNegative)
+	}
+	if data.Attempts < 0 {
+		return StockNotification{}, fmt.Errorf("%w: attempts", ErrNegative)
+	}
+
+	return StockNotification{
+		id:        data.ID,
+		userID:    data.UserID,
+		email:     data.Email,
+		bookID:    data.BookID,
+		title:     data.Title,
+		attempts:  data.Attempts,
+		createdAt: data.CreatedAt,
+	}, nil
+}
+
+// ID returns the notification identifier.
+func (n StockNotification) ID() int {
+	return n.id
+}
+
+// UserID returns the identifier of the user to notify.
+func (n StockNotification) UserID() int {
+	return n.userID
+}
+
+// Email returns the address of the user to notify.
+func (n StockNotification) Email() string {
+	return n.email
+}
+
+// BookID returns the identifier of the book that is back in stock.
+func (n StockNotification) BookID() int {
+	return n.bookID
+}
+
+// Title returns the title of the book that is back in stock.
+func (n StockNotification) Title() string {
+	return n.title
+}
+
+// Attempts returns how many times sending the notification has failed.
+func (n StockNotification) Attempts() int {
+	return n.attempts
+}
+
+// CreatedAt returns when the book came back in stock.
+func (n StockNotification) CreatedAt() time.Time {
+	return n.createdAt
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookSubscription_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewBookSubscriptionData
+		expectedErr error
+	}{
+		{"Zero user ID", NewBookSubscriptionData{BookID: 1}, ErrInvalidUserID},
+		{"Zero book ID", NewBookSubscriptionData{UserID: 7}, ErrNegative},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			subscription, err := NewBookSubscription(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, BookSubscription{}, subscription)
+		})
+	}
+}
+
+func TestNewStockNotification_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewStockNotificationData
+		expectedErr error
+	}{
+		{"Zero user ID", NewStockNotificationData{Email: "reader@example.com", BookID: 1}, ErrInvalidUserID},
+		{"Missing email", NewStockNotificationData{UserID: 7, BookID: 1}, ErrRequired},
+		{"Zero book ID", NewStockNotificationData{UserID: 7, Email: "reader@example.com"}, ErrNegative},
+		{"Negative attempts", NewStockNotificationData{UserID: 7, Email: "reader@example.com", BookID: 1, Attempts: -1}, ErrNegative},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			notification, err := NewStockNotification(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, StockNotification{}, notification)
+		})
+	}
+}
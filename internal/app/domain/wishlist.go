@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxWishlistNameLength is the longest name a wishlist can have.
+const MaxWishlistNameLength = 100
+
+// Wishlist is a named list of books a user keeps for later. Unlike a cart it reserves no stock.
+type Wishlist struct {
+	id        int
+	userID    int
+	name      string
+	items     []WishlistItem
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+type NewWishlistData struct {
+	ID        int
+	UserID    int
+	Name      string
+	Items     []NewWishlistItemData
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WishlistItem is a book kept in a wishlist.
+type WishlistItem struct {
+	bookID  int
+	title   string
+	price   int
+	stock   int
+	addedAt time.Time
+}
+
+type NewWishlistItemData struct {
+	BookID  int
+	Title   string
+	Price   int
+	Stock   int
+	AddedAt time.Time
+}
+
+// NewWishlist constructs a Wishlist from the provided data, the name is trimmed.
+func NewWishlist(data NewWishlistData) (Wishlist, error) {
+	if data.UserID == 0 {
+		return Wishlist{}, fmt.Errorf("%w: user_id", ErrInvalidUserID)
+	}
+	name, err := validateWishlistName(data.Name)
+	if err != nil {
+		return Wishlist{}, err
+	}
+
+	items := make([]WishlistItem, 0, len(data.Items))
+	for _, itemData := range data.Items {
+		if itemData.BookID <= 0 {
+			return Wishlist{}, fmt.Errorf("%w: book_id", ErrNegative)
+		}
+		if slices.ContainsFunc(items, func(item WishlistItem) bool { return item.bookID == itemData.BookID }) {
+			return Wishlist{}, fmt.Errorf("%w: book %d is listed twice", ErrInvalidBookIDs, itemData.BookID)
+		}
+		items = append(items, WishlistItem{
+			bookID:  itemData.BookID,
+			title:   itemData.Title,
+			price:   itemData.Price,
+			stock:   itemData.Stock,
+			addedAt: itemData.AddedAt,
+		})
+	}
+
+	return Wishlist{
+		id:        data.ID,
+		userID:    data.UserID,
+		name:      name,
+		items:     items,
+		createdAt: data.CreatedAt,
+		updatedAt: data.UpdatedAt,
+	}, nil
+}
+
+func validateWishlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name", ErrRequired)
+	}
+	if len([]rune(name)) > MaxWishlistNameLength {
+		return "", fmt.Errorf("%w: longer than %d characters", ErrInvalidWishlistName, MaxWishlistNameLength)
+	}
+	return name, nil
+}
+
+// ID returns the wishlist identifier.
+func (w Wishlist) ID() int {
+	return w.id
+}
+
+// UserID returns the identifier of the wishlist owner.
+func (w Wishlist) UserID() int {
+	return w.userID
+}
+
+// Name returns the wishlist name.
+func (w Wishlist) Name() string {
+	return w.name
+}
+
+// Items returns the books in the wishlist.
+func (w Wishlist) Items() []WishlistItem {
+	return w.items
+}
+
+// BookIDs returns the IDs of the books in the wishlist.
+func (w Wishlist) BookIDs() []int {
+	bookIDs := make([]int, 0, len(w.items))
+	for _, item := range w.items {
+		bookIDs = append(bookIDs, item.bookID)
+	}
+	return bookIDs
+}
+
+// HasBook checks if a book with the given ID is in the wishlist.
+func (w Wishlist) HasBook(bookID int) bool {
+	return slices.Contains(w.BookIDs(), bookID)
+}
+
+// CreatedAt returns the time the wishlist was created.
+func (w Wishlist) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// UpdatedAt returns the time the wishlist was last changed.
+func (w Wishlist) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// CanBeViewedBy reports whether the user is allowed to see and change the wishlist, only its owner is.
+func (w Wishlist) CanBeViewedBy(user User) bool {
+	return user.ID() == w.userID
+}
+
+// Rename gives the wishlist a new name.
+func (w *Wishlist) Rename(name string, at time.Time) error {
+	name, err := validateWishlistName(name)
+	if err != nil {
+		return err
+	}
+	w.name = name
+	w.updatedAt = at
+	return nil
+}
+
+// AddBook puts a book in the wishlist, a book that is already there is left as is.
+func (w *Wishlist) AddBook(bookID int, at time.Time) error {
+	if bookID <= 0 {
+		return fmt.Errorf("%w: book_id", ErrNegative)
+	}
+	if w.HasBook(bookID) {
+		return nil
+	}
+	w.items = append(w.items, WishlistItem{bookID: bookID, addedAt: at})
+	w.updatedAt = at
+	return nil
+}
+
+// RemoveBook takes a book out of the wishlist.
+func (w *Wishlist) RemoveBook(bookID int, at time.Time) {
+	i := slices.IndexFunc(w.items, func(item WishlistItem) bool { return item.bookID == bookID })
+	if i < 0 {
+		return
+	}
+	w.items = slices.Delete(w.items, i, i+1)
+	w.updatedAt = at
+}
+
+// BookID returns the identifier of the book.
+func (i WishlistItem) BookID() int {
+	return i.bookID
+}
+
+// Title returns the book title.
+func (i WishlistItem) Title() string {
+	return i.title
+}
+
+// Price returns the current book price.
+func (i WishlistItem) Price() int {
+	return i.price
+}
+
+// InStock reports whether the book can be put in a cart right now.
+func (i WishlistItem) InStock() bool {
+	return i.stock > 0
+}
+
+// AddedAt returns the time the book was put in the wishlist.
+func (i WishlistItem) AddedAt() time.Time {
+	return i.addedAt
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWishlist_InvalidData(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        NewWishlistData
+		expectedErr error
+	}{
+		{"Zero user ID", NewWishlistData{Name: "Later"}, ErrInvalidUserID},
+		{"Blank name", NewWishlistData{UserID: 7, Name: "  "}, ErrRequired},
+		{"Long name", NewWishlistData{UserID: 7, Name: strings.Repeat("a", MaxWishlistNameLength+1)}, ErrInvalidWishlistName},
+		{"Zero book ID", NewWishlistData{UserID: 7, Name: "Later", Items: []NewWishlistItemData{{}}}, ErrNegative},
+		{"Duplicate book", NewWishlistData{UserID: 7, Name: "Later", Items: []NewWishlistItemData{{BookID: 1}, {BookID: 1}}}, ErrInvalidBookIDs},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			wishlist, err := NewWishlist(tc.data)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, Wishlist{}, wishlist)
+		})
+	}
+}
+
+func TestWishlist_AddAndRemoveBooks(t *testing.T) {
+	// Arrange
+	wishlist, err := NewWishlist(NewWishlistData{UserID: 7, Name: " Saved for later ", Items: []NewWishlistItemData{{BookID: 1, Stock: 2}}})
+	require.NoError(t, err)
+	at := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	require.NoError(t, wishlist.AddBook(2, at))
+	require.NoError(t, wishlist.AddBook(1, at))
+	wishlist.RemoveBook(1, at)
+
+	// Assert
+	assert.Equal(t, "Saved for later", wishlist.Name())
+	assert.Equal(t, []int{2}, wishlist.BookIDs())
+	assert.Equal(t, at, wishlist.Items()[0].AddedAt())
+	assert.Equal(t, at, wishlist.UpdatedAt())
+}
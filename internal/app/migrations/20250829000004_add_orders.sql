@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orders (
+   id  serial NOT NULL PRIMARY KEY,
+   user_id integer NOT NULL,
+   total integer NOT NULL CHECK (total >= 0),
+   status text NOT NULL,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   updated_at 		timestamp with time zone,
+
+   FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS order_items (
+   id  serial NOT NULL PRIMARY KEY,
+   order_id integer NOT NULL,
+   book_id integer,
+   title text NOT NULL,
+   price integer NOT NULL CHECK (price > 0),
+
+   FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_items_order_id_idx ON order_items (order_id);
+
+-- +goose Down
+DROP TABLE order_items;
+DROP TABLE orders;
@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_idx ON orders (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS orders_created_at_idx ON orders (created_at DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS orders_created_at_idx;
+DROP INDEX IF EXISTS orders_user_id_created_at_idx;
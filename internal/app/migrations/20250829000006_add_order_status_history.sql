@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_status_history (
+   id  serial NOT NULL PRIMARY KEY,
+   order_id integer NOT NULL,
+   from_status text NOT NULL,
+   to_status text NOT NULL,
+   actor_id integer NOT NULL,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+
+   FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+   FOREIGN KEY (actor_id) REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_idx ON order_status_history (order_id);
+
+-- +goose Down
+DROP TABLE order_status_history;
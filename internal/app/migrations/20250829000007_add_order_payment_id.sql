@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS payment_id text;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_id;
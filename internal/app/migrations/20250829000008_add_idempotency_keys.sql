@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+   user_id integer NOT NULL,
+   key text NOT NULL,
+   request_hash text NOT NULL,
+   status_code integer,
+   response bytea,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   completed_at 	timestamp with time zone,
+
+   PRIMARY KEY (user_id, key),
+   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at_idx ON idempotency_keys (created_at);
+
+-- +goose Down
+DROP TABLE idempotency_keys;
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS cart_items (
+   user_id integer NOT NULL,
+   book_id integer NOT NULL,
+   reserved_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   expires_at 		timestamp with time zone 	NOT NULL,
+
+   PRIMARY KEY (user_id, book_id),
+   FOREIGN KEY (user_id) REFERENCES carts(user_id) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS cart_items_expires_at_idx ON cart_items (expires_at);
+CREATE INDEX IF NOT EXISTS cart_items_book_id_idx ON cart_items (book_id);
+
+-- the books reserved so far expire together with their cart
+INSERT INTO cart_items (user_id, book_id, reserved_at, expires_at)
+SELECT DISTINCT c.user_id, b.id,
+       coalesce(c.updated_at, c.created_at),
+       coalesce(c.updated_at, c.created_at) + interval '30 minutes'
+FROM carts c
+CROSS JOIN LATERAL unnest(c.book_ids) AS cart_book(book_id)
+JOIN books b ON b.id = cart_book.book_id
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE carts DROP COLUMN book_ids;
+
+-- +goose Down
+ALTER TABLE carts ADD COLUMN book_ids integer[] NOT NULL DEFAULT '{}';
+
+UPDATE carts c SET book_ids = coalesce(
+    (SELECT array_agg(i.book_id ORDER BY i.reserved_at, i.book_id) FROM cart_items i WHERE i.user_id = c.user_id),
+    '{}'
+);
+
+DROP TABLE cart_items;
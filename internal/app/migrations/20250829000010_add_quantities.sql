@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE cart_items ADD COLUMN IF NOT EXISTS quantity integer NOT NULL DEFAULT 1 CHECK (quantity > 0);
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS quantity integer NOT NULL DEFAULT 1 CHECK (quantity > 0);
+
+-- +goose Down
+ALTER TABLE order_items DROP COLUMN IF EXISTS quantity;
+ALTER TABLE cart_items DROP COLUMN IF EXISTS quantity;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS guest_carts (
+   token text NOT NULL PRIMARY KEY,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   updated_at 		timestamp with time zone
+);
+
+CREATE TABLE IF NOT EXISTS guest_cart_items (
+   token text NOT NULL,
+   book_id integer NOT NULL,
+   quantity integer NOT NULL DEFAULT 1 CHECK (quantity > 0),
+   reserved_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   expires_at 		timestamp with time zone 	NOT NULL,
+
+   PRIMARY KEY (token, book_id),
+   FOREIGN KEY (token) REFERENCES guest_carts(token) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS guest_cart_items_expires_at_idx ON guest_cart_items (expires_at);
+CREATE INDEX IF NOT EXISTS guest_cart_items_book_id_idx ON guest_cart_items (book_id);
+
+-- +goose Down
+DROP TABLE guest_cart_items;
+DROP TABLE guest_carts;
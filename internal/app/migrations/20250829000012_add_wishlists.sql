@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS wishlists (
+   id serial NOT NULL PRIMARY KEY,
+   user_id integer NOT NULL,
+   name text NOT NULL,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   updated_at 		timestamp with time zone,
+
+   UNIQUE (user_id, name),
+   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS wishlist_items (
+   wishlist_id integer NOT NULL,
+   book_id integer NOT NULL,
+   added_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+
+   PRIMARY KEY (wishlist_id, book_id),
+   FOREIGN KEY (wishlist_id) REFERENCES wishlists(id) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS wishlist_items_book_id_idx ON wishlist_items (book_id);
+
+-- +goose Down
+DROP TABLE wishlist_items;
+DROP TABLE wishlists;
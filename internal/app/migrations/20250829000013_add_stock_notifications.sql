@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS book_subscriptions (
+   user_id integer NOT NULL,
+   book_id integer NOT NULL,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+
+   PRIMARY KEY (user_id, book_id),
+   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS book_subscriptions_book_id_idx ON book_subscriptions (book_id);
+
+CREATE TABLE IF NOT EXISTS stock_notifications (
+   id serial PRIMARY KEY,
+   user_id integer NOT NULL,
+   book_id integer NOT NULL,
+   attempts integer NOT NULL DEFAULT 0,
+   last_error text,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+   sent_at 		timestamp with time zone,
+
+   FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+   FOREIGN KEY (book_id) REFERENCES books(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS stock_notifications_pending_idx ON stock_notifications (id) WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP TABLE stock_notifications;
+DROP TABLE book_subscriptions;
@@ -0,0 +1,58 @@
+-- +goose Up
+-- the ledger outlives the books, so book_id is not a foreign key
+CREATE TABLE IF NOT EXISTS inventory_movements (
+   id bigserial PRIMARY KEY,
+   book_id integer NOT NULL,
+   kind text NOT NULL CHECK (kind IN ('restock', 'reservation', 'release', 'sale', 'adjustment')),
+   quantity integer NOT NULL CHECK (quantity <> 0),
+   reason text NOT NULL DEFAULT '',
+   actor_id integer,
+   created_at 		timestamp with time zone 	DEFAULT now() NOT NULL,
+
+   FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS inventory_movements_book_id_idx ON inventory_movements (book_id, id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION inventory_movements_append_only() RETURNS trigger AS $$
+BEGIN
+   -- removing a user forgets who caused the movement, nothing else may change
+   IF TG_OP = 'UPDATE' AND NEW.actor_id IS NULL AND OLD.actor_id IS NOT NULL
+      AND (NEW.id, NEW.book_id, NEW.kind, NEW.quantity, NEW.reason, NEW.created_at)
+          IS NOT DISTINCT FROM (OLD.id, OLD.book_id, OLD.kind, OLD.quantity, OLD.reason, OLD.created_at) THEN
+      RETURN NEW;
+   END IF;
+   RAISE EXCEPTION 'inventory_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER inventory_movements_append_only
+   BEFORE UPDATE OR DELETE ON inventory_movements
+   FOR EACH ROW EXECUTE FUNCTION inventory_movements_append_only();
+
+-- the opening balance restocks the copies the books had before the carts and orders took theirs,
+-- so that the ledger adds up to the current stock and the reconciliations count the copies as restocked.
+-- the orders that were cancelled or refunded before they shipped aren't sold, as in the reconciliations
+CREATE TEMPORARY TABLE opening_balances ON COMMIT DROP AS
+SELECT b.id AS book_id,
+       b.stock,
+       COALESCE((SELECT SUM(ci.quantity) FROM cart_items ci WHERE ci.book_id = b.id), 0)
+         + COALESCE((SELECT SUM(gi.quantity) FROM guest_cart_items gi WHERE gi.book_id = b.id), 0) AS reserved,
+       COALESCE((SELECT SUM(oi.quantity) FROM order_items oi JOIN orders o ON o.id = oi.order_id
+                 WHERE oi.book_id = b.id AND o.status <> 'cancelled'
+                   AND NOT EXISTS (SELECT 1 FROM order_status_history h
+                                   WHERE h.order_id = o.id AND h.from_status = 'paid' AND h.to_status = 'refunded')), 0) AS sold
+FROM books b;
+
+INSERT INTO inventory_movements (book_id, kind, quantity, reason)
+SELECT book_id, 'restock', stock + reserved + sold, 'opening balance' FROM opening_balances WHERE stock + reserved + sold > 0;
+INSERT INTO inventory_movements (book_id, kind, quantity, reason)
+SELECT book_id, 'reservation', -reserved, 'opening balance' FROM opening_balances WHERE reserved > 0;
+INSERT INTO inventory_movements (book_id, kind, quantity, reason)
+SELECT book_id, 'sale', -sold, 'opening balance' FROM opening_balances WHERE sold > 0;
+
+-- +goose Down
+DROP TABLE inventory_movements;
+DROP FUNCTION inventory_movements_append_only();
@@ -0,0 +1,6 @@
+-- +goose Up
+-- stock reconciliation sums the sold copies of every book
+CREATE INDEX IF NOT EXISTS order_items_book_id_idx ON order_items (book_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS order_items_book_id_idx;
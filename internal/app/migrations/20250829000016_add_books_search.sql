@@ -0,0 +1,12 @@
+-- +goose Up
+-- titles weigh more than authors when the search results are ranked
+ALTER TABLE books ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+   setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+   setweight(to_tsvector('english', coalesce(author, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS books_search_idx ON books USING GIN (search);
+
+-- +goose Down
+DROP INDEX IF EXISTS books_search_idx;
+ALTER TABLE books DROP COLUMN IF EXISTS search;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- the suggestions match the titles and authors by trigram word similarity as the user types
+CREATE INDEX IF NOT EXISTS books_title_trgm_idx ON books USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS books_author_trgm_idx ON books USING GIN (author gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS books_author_trgm_idx;
+DROP INDEX IF EXISTS books_title_trgm_idx;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- every sort of the book listing breaks its ties by id
+CREATE INDEX IF NOT EXISTS books_price_id_idx ON books (price, id);
+CREATE INDEX IF NOT EXISTS books_year_id_idx ON books (year, id);
+CREATE INDEX IF NOT EXISTS books_title_id_idx ON books (title, id);
+CREATE INDEX IF NOT EXISTS books_created_at_id_idx ON books (created_at, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS books_created_at_id_idx;
+DROP INDEX IF EXISTS books_title_id_idx;
+DROP INDEX IF EXISTS books_year_id_idx;
+DROP INDEX IF EXISTS books_price_id_idx;
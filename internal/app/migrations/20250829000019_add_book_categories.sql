@@ -0,0 +1,19 @@
+-- +goose Up
+-- a book belongs to several categories, books.category_id stays its primary category and is one of them
+CREATE TABLE IF NOT EXISTS book_categories (
+   book_id integer NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+   category_id integer NOT NULL REFERENCES categories(id),
+   created_at timestamp with time zone DEFAULT now() NOT NULL,
+
+   PRIMARY KEY (book_id, category_id)
+);
+
+-- the category filter and facets look the books of a category up
+CREATE INDEX IF NOT EXISTS book_categories_category_id_idx ON book_categories (category_id, book_id);
+
+INSERT INTO book_categories (book_id, category_id)
+SELECT id, category_id FROM books WHERE category_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS book_categories;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- categories nest under a parent, the root categories have none
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id integer REFERENCES categories(id);
+ALTER TABLE categories ADD CONSTRAINT categories_parent_id_check CHECK (parent_id <> id);
+
+-- the tree and the subcategories of the book filter walk down from the parents
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS categories_parent_id_idx;
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
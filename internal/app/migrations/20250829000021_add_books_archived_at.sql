@@ -0,0 +1,6 @@
+-- +goose Up
+-- the books of a deleted category can be archived, they are kept for the orders but hidden from the listings
+ALTER TABLE books ADD COLUMN IF NOT EXISTS archived_at timestamp with time zone;
+
+-- +goose Down
+ALTER TABLE books DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,46 @@
+-- +goose Up
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS slug text;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS description text NOT NULL DEFAULT '';
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS display_order integer NOT NULL DEFAULT 0;
+
+-- the existing categories get the slugs domain.Slugify would generate
+UPDATE categories SET slug = trim(BOTH '-' FROM left(regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g'), 100));
+UPDATE categories SET slug = 'category' WHERE slug = '';
+
+-- the later duplicates get the first numeric suffix no other category has, like the generated slugs of new categories
+-- +goose StatementBegin
+DO $$
+DECLARE
+   duplicate record;
+   candidate text;
+   n integer;
+BEGIN
+   FOR duplicate IN
+      SELECT c.id, c.slug FROM categories AS c
+      WHERE EXISTS (SELECT 1 FROM categories AS o WHERE o.slug = c.slug AND o.id < c.id)
+      ORDER BY c.id
+   LOOP
+      n := 2;
+      LOOP
+         candidate := rtrim(left(duplicate.slug, 100 - length('-' || n)), '-') || '-' || n;
+         EXIT WHEN NOT EXISTS (SELECT 1 FROM categories WHERE slug = candidate);
+         n := n + 1;
+      END LOOP;
+      UPDATE categories SET slug = candidate WHERE id = duplicate.id;
+   END LOOP;
+END;
+$$;
+-- +goose StatementEnd
+
+ALTER TABLE categories ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS categories_slug_key ON categories (slug);
+
+-- the listing and the tree order the categories by their display order
+CREATE INDEX IF NOT EXISTS categories_display_order_idx ON categories (display_order, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS categories_display_order_idx;
+DROP INDEX IF EXISTS categories_slug_key;
+ALTER TABLE categories DROP COLUMN IF EXISTS display_order;
+ALTER TABLE categories DROP COLUMN IF EXISTS description;
+ALTER TABLE categories DROP COLUMN IF EXISTS slug;
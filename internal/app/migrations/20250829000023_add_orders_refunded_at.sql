@@ -0,0 +1,10 @@
+-- +goose Up
+-- the refund is made after the status change is saved, orders that are cancelled or refunded without it wait for a retry
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS refunded_at timestamp with time zone;
+
+-- the orders refunded before were refunded together with their status change
+UPDATE orders SET refunded_at = updated_at
+WHERE payment_id IS NOT NULL AND status IN ('cancelled', 'refunded');
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS refunded_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- a dispatcher claims the notifications it sends, so that several instances don't send the same notification.
+-- a claim that is not released (the dispatcher stopped while sending) expires and the notification is sent again
+ALTER TABLE stock_notifications ADD COLUMN IF NOT EXISTS claimed_at timestamp with time zone;
+
+-- +goose Down
+ALTER TABLE stock_notifications DROP COLUMN IF EXISTS claimed_at;
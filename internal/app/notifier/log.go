@@ -0,0 +1,50 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"toptal/internal/app/domain"
+)
+
+type logEntry struct {
+	Time   time.Time `json:"time"`
+	UserID int       `json:"user_id"`
+	Email  string    `json:"email"`
+	BookID int       `json:"book_id"`
+	Title  string    `json:"title"`
+}
+
+// LogNotifier writes every notification as a JSON line, to a file or to the process output.
+// It is meant for development and for deployments without a mail server.
+type LogNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewLogNotifier creates a notifier writing to w
+func NewLogNotifier(w io.Writer) *LogNotifier {
+	return &LogNotifier{w: w}
+}
+
+// NotifyBackInStock writes the notification
+func (n *LogNotifier) NotifyBackInStock(_ context.Context, notification domain.StockNotification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err := json.NewEncoder(n.w).Encode(logEntry{
+		Time:   time.Now().UTC(),
+		UserID: notification.UserID(),
+		Email:  notification.Email(),
+		BookID: notification.BookID(),
+		Title:  notification.Title(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+
+	return nil
+}
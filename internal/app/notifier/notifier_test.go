@@ -0,0 +1,169 @@
+package notifier
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"toptal/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNotification(t *testing.T) domain.StockNotification {
+	notification, err := domain.NewStockNotification(domain.NewStockNotificationData{
+		ID:     1,
+		UserID: 7,
+		Email:  "reader@example.com",
+		BookID: 3,
+		Title:  "Clean Architecture",
+	})
+	require.NoError(t, err)
+	return notification
+}
+
+// fakeSMTPServer accepts mail on a local port and keeps the received messages
+type fakeSMTPServer struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu         sync.Mutex
+	recipients []string
+	messages   []string
+}
+
+func startFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &fakeSMTPServer{listener: listener}
+	server.wg.Add(1)
+	go server.serve()
+	t.Cleanup(func() {
+		_ = listener.Close()
+		server.wg.Wait()
+	})
+
+	return server
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost fake smtp")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.mu.Lock()
+			s.recipients = append(s.recipients, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var message strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				message.WriteString(dataLine)
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, message.String())
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier_NotifyBackInStock_SendsMail(t *testing.T) {
+	// Arrange
+	server := startFakeSMTPServer(t)
+	notifier, err := NewSMTPNotifier(SMTPConfig{
+		Addr: server.listener.Addr().String(),
+		From: "shop@example.com",
+	})
+	require.NoError(t, err)
+
+	// Act
+	err = notifier.NotifyBackInStock(context.Background(), newTestNotification(t))
+
+	// Assert
+	require.NoError(t, err)
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, []string{"reader@example.com"}, server.recipients)
+	require.Len(t, server.messages, 1)
+	assert.Contains(t, server.messages[0], "To: reader@example.com\r\n")
+	assert.Contains(t, server.messages[0], `Subject: "Clean Architecture" is back in stock`)
+}
+
+func TestSMTPNotifier_NotifyBackInStock_ServerDown(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	notifier, err := NewSMTPNotifier(SMTPConfig{Addr: addr, From: "shop@example.com"})
+	require.NoError(t, err)
+
+	// Act
+	err = notifier.NotifyBackInStock(context.Background(), newTestNotification(t))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestLogNotifier_NotifyBackInStock_WritesJSONLine(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	notifier := NewLogNotifier(&output)
+
+	// Act
+	err := notifier.NotifyBackInStock(context.Background(), newTestNotification(t))
+
+	// Assert
+	require.NoError(t, err)
+	var entry logEntry
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.Equal(t, "reader@example.com", entry.Email)
+	assert.Equal(t, 3, entry.BookID)
+	assert.Equal(t, "Clean Architecture", entry.Title)
+}
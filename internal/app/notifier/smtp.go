@@ -0,0 +1,115 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+	"toptal/internal/app/domain"
+)
+
+// SMTPConfig is the mail server the notifications are sent through.
+// Username and Password are optional, the credentials are only sent over TLS or to localhost.
+type SMTPConfig struct {
+	Addr     string
+	From     string
+	Username string
+	Password string
+}
+
+// SMTPNotifier emails the notifications.
+type SMTPNotifier struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPNotifier creates a notifier sending mail through the configured server
+func NewSMTPNotifier(cfg SMTPConfig) (*SMTPNotifier, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("%w: smtp address", domain.ErrRequired)
+	}
+	if cfg.From == "" {
+		return nil, fmt.Errorf("%w: sender address", domain.ErrRequired)
+	}
+
+	return &SMTPNotifier{cfg: cfg}, nil
+}
+
+// NotifyBackInStock emails the subscriber, the server connection is closed when ctx is done
+func (n *SMTPNotifier) NotifyBackInStock(ctx context.Context, notification domain.StockNotification) error {
+	host, _, err := net.SplitHostPort(n.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("invalid smtp address: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+	if n.cfg.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, host))
+		if err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	err = client.Mail(n.cfg.From)
+	if err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+	err = client.Rcpt(notification.Email())
+	if err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message: %w", err)
+	}
+	_, err = w.Write(n.message(notification))
+	if err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) message(notification domain.StockNotification) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", notification.Email())
+	fmt.Fprintf(&msg, "Subject: %q is back in stock\r\n", notification.Title())
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	fmt.Fprintf(&msg, "Good news! %q (book %d) is back in stock.\r\n", notification.Title(), notification.BookID())
+	msg.WriteString("Books are reserved when they are put in the cart, so be quick.\r\n")
+
+	return msg.Bytes()
+}
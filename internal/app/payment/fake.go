@@ -0,0 +1,201 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"toptal/internal/app/domain"
+)
+
+// Outcome is the scripted result of a payment authorization.
+type Outcome int
+
+const (
+	Approve Outcome = iota
+	Decline
+	// Timeout authorizes the payment but never answers, like a provider whose response is lost
+	Timeout
+	// FailCapture approves the authorization and fails its capture
+	FailCapture
+)
+
+type fakePayment struct {
+	orderID     int
+	amount      int
+	failCapture bool
+	captured    bool
+	voided      bool
+	refunded    bool
+}
+
+// FakeProvider is an in-process payment provider for development and tests.
+// Authorizations follow the script in order and are approved once it runs out.
+type FakeProvider struct {
+	mu       sync.Mutex
+	script   []Outcome
+	payments map[string]*fakePayment
+	lastID   int
+}
+
+// NewFakeProvider creates a fake payment provider with the given script
+func NewFakeProvider(script ...Outcome) *FakeProvider {
+	return &FakeProvider{
+		script:   script,
+		payments: make(map[string]*fakePayment),
+	}
+}
+
+// Script appends outcomes for the next authorizations
+func (p *FakeProvider) Script(outcomes ...Outcome) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.script = append(p.script, outcomes...)
+}
+
+// Authorize approves, declines or times out the payment according to the script.
+// A timed out authorization holds the money and blocks until the context is done.
+func (p *FakeProvider) Authorize(ctx context.Context, order domain.Order) (string, error) {
+	p.mu.Lock()
+	outcome := Approve
+	if len(p.script) > 0 {
+		outcome = p.script[0]
+		p.script = p.script[1:]
+	}
+	if outcome == Decline {
+		p.mu.Unlock()
+		return "", fmt.Errorf("%w: order %d", domain.ErrPaymentDeclined, order.ID())
+	}
+
+	p.lastID++
+	paymentID := "fake_" + strconv.Itoa(p.lastID)
+	p.payments[paymentID] = &fakePayment{orderID: order.ID(), amount: order.Total(), failCapture: outcome == FailCapture}
+	p.mu.Unlock()
+
+	if outcome == Timeout {
+		<-ctx.Done()
+		return "", fmt.Errorf("%w: %w", domain.ErrPaymentTimeout, ctx.Err())
+	}
+
+	return paymentID, nil
+}
+
+// Capture takes the authorized amount
+func (p *FakeProvider) Capture(_ context.Context, paymentID string, amount int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrPaymentNotFound, paymentID)
+	}
+	if payment.captured {
+		return fmt.Errorf("payment %s is already captured", paymentID)
+	}
+	if payment.voided {
+		return fmt.Errorf("payment %s is voided", paymentID)
+	}
+	if payment.failCapture {
+		return fmt.Errorf("payment %s capture failed", paymentID)
+	}
+	if amount != payment.amount {
+		return fmt.Errorf("capture amount %d does not match authorized amount %d", amount, payment.amount)
+	}
+	payment.captured = true
+
+	return nil
+}
+
+// Void releases an authorization that wasn't captured
+func (p *FakeProvider) Void(_ context.Context, paymentID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrPaymentNotFound, paymentID)
+	}
+	if payment.captured {
+		return fmt.Errorf("payment %s is captured and cannot be voided", paymentID)
+	}
+	payment.voided = true
+
+	return nil
+}
+
+// VoidOrder releases the authorizations of the order that weren't captured
+func (p *FakeProvider) VoidOrder(_ context.Context, orderID int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, payment := range p.payments {
+		if payment.orderID == orderID && !payment.captured {
+			payment.voided = true
+		}
+	}
+
+	return nil
+}
+
+// Refund returns the captured amount, refunding it again does nothing
+func (p *FakeProvider) Refund(_ context.Context, paymentID string, amount int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return fmt.Errorf("%w: %s", domain.ErrPaymentNotFound, paymentID)
+	}
+	if !payment.captured {
+		return fmt.Errorf("payment %s cannot be refunded", paymentID)
+	}
+	if amount > payment.amount {
+		return fmt.Errorf("refund amount %d exceeds captured amount %d", amount, payment.amount)
+	}
+	if payment.refunded {
+		return nil
+	}
+	payment.refunded = true
+
+	return nil
+}
+
+// Captured reports whether the payment was captured
+func (p *FakeProvider) Captured(paymentID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	return ok && payment.captured
+}
+
+// Voided reports whether the authorization was voided
+func (p *FakeProvider) Voided(paymentID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	return ok && payment.voided
+}
+
+// Held reports whether an authorization of the order holds money, it's neither captured nor voided
+func (p *FakeProvider) Held(orderID int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, payment := range p.payments {
+		if payment.orderID == orderID && !payment.captured && !payment.voided {
+			return true
+		}
+	}
+	return false
+}
+
+// Refunded reports whether the payment was refunded
+func (p *FakeProvider) Refunded(paymentID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	return ok && payment.refunded
+}
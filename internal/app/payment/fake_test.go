@@ -0,0 +1,131 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+	"toptal/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOrder(t *testing.T) domain.Order {
+	order, err := domain.NewOrder(domain.NewOrderData{
+		ID:     1,
+		UserID: 7,
+		Items:  []domain.NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000}},
+		Status: domain.OrderStatusPending,
+	})
+	require.NoError(t, err)
+	return order
+}
+
+func TestFakeProvider_Approve_CaptureAndRefund(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider()
+	order := newTestOrder(t)
+	ctx := context.Background()
+
+	// Act
+	paymentID, err := provider.Authorize(ctx, order)
+	require.NoError(t, err)
+	captureErr := provider.Capture(ctx, paymentID, order.Total())
+	refundErr := provider.Refund(ctx, paymentID, order.Total())
+	retriedRefundErr := provider.Refund(ctx, paymentID, order.Total())
+
+	// Assert
+	assert.NotEmpty(t, paymentID)
+	assert.NoError(t, captureErr)
+	assert.NoError(t, refundErr)
+	assert.NoError(t, retriedRefundErr, "a retried refund succeeds without refunding twice")
+	assert.True(t, provider.Refunded(paymentID))
+}
+
+func TestFakeProvider_Decline_ReturnsDeclinedError(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider(Decline)
+	order := newTestOrder(t)
+
+	// Act
+	paymentID, err := provider.Authorize(context.Background(), order)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrPaymentDeclined)
+	assert.Empty(t, paymentID)
+
+	// the script is exhausted, so the next payment is approved
+	_, err = provider.Authorize(context.Background(), order)
+	assert.NoError(t, err)
+}
+
+func TestFakeProvider_Timeout_WaitsForContext(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider()
+	provider.Script(Timeout)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Act
+	_, err := provider.Authorize(ctx, newTestOrder(t))
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrPaymentTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFakeProvider_Timeout_VoidOrderReleasesHold(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider(Timeout)
+	order := newTestOrder(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := provider.Authorize(ctx, order)
+	require.ErrorIs(t, err, domain.ErrPaymentTimeout)
+	require.True(t, provider.Held(order.ID()), "the timed out authorization holds the money")
+
+	// Act
+	err = provider.VoidOrder(context.Background(), order.ID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, provider.Held(order.ID()))
+}
+
+func TestFakeProvider_InvalidOperations(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider()
+	order := newTestOrder(t)
+	ctx := context.Background()
+	paymentID, err := provider.Authorize(ctx, order)
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.ErrorIs(t, provider.Capture(ctx, "unknown", order.Total()), domain.ErrPaymentNotFound)
+	assert.Error(t, provider.Refund(ctx, paymentID, order.Total()), "refund before capture")
+	assert.Error(t, provider.Capture(ctx, paymentID, order.Total()+1), "capture a different amount")
+	require.NoError(t, provider.Capture(ctx, paymentID, order.Total()))
+	assert.Error(t, provider.Capture(ctx, paymentID, order.Total()), "capture twice")
+}
+
+func TestFakeProvider_FailCapture_VoidsAuthorization(t *testing.T) {
+	// Arrange
+	provider := NewFakeProvider(FailCapture)
+	order := newTestOrder(t)
+	ctx := context.Background()
+	paymentID, err := provider.Authorize(ctx, order)
+	require.NoError(t, err)
+
+	// Act
+	captureErr := provider.Capture(ctx, paymentID, order.Total())
+	voidErr := provider.Void(ctx, paymentID)
+
+	// Assert
+	assert.Error(t, captureErr)
+	assert.NoError(t, voidErr)
+	assert.False(t, provider.Captured(paymentID))
+	assert.True(t, provider.Voided(paymentID))
+	assert.Error(t, provider.Capture(ctx, paymentID, order.Total()), "capture after void")
+}
@@ -18,4 +18,20 @@ type Book struct {
 	CategoryID    int
 	CreatedAt     time.Time `bun:",nullzero"`
 	UpdatedAt     time.Time `bun:",nullzero"`
+	// ArchivedAt is set when the category of the book was deleted with the archive policy
+	ArchivedAt time.Time `bun:",nullzero"`
+	// Search is generated by the database from the title and the author
+	Search string `bun:",scanonly"`
+	// CategoryIDs are all the categories of the book from book_categories, CategoryID is the primary one
+	CategoryIDs []int `bun:",array,scanonly"`
+	// Rank is the relevance of the book to a search query, it's only selected when the books are searched
+	Rank float32 `bun:",scanonly"`
+}
+
+// BookCategory links a book to one of its categories.
+type BookCategory struct {
+	bun.BaseModel `bun:"table:book_categories,alias:book_category"`
+	BookID        int       `bun:",pk"`
+	CategoryID    int       `bun:",pk"`
+	CreatedAt     time.Time `bun:",nullzero"`
 }
@@ -8,8 +8,37 @@ import (
 
 type Cart struct {
 	bun.BaseModel `bun:"table:carts"`
-	UserID        int       `bun:"user_id"`
-	BookIDs       []int     `bun:"book_ids,array"`
+	UserID        int       `bun:"user_id,pk"`
 	CreatedAt     time.Time `bun:"created_at,nullzero,default:current_timestamp"`
 	UpdatedAt     time.Time `bun:"updated_at,nullzero"`
 }
+
+// CartItem is a book reserved in a cart until ExpiresAt.
+type CartItem struct {
+	bun.BaseModel `bun:"table:cart_items,alias:cart_item"`
+	UserID        int `bun:",pk"`
+	BookID        int `bun:",pk"`
+	Quantity      int
+	ReservedAt    time.Time `bun:",nullzero"`
+	ExpiresAt     time.Time
+	Book          *Book `bun:"rel:belongs-to,join:book_id=id"`
+}
+
+// GuestCart is the cart of an anonymous visitor identified by an opaque token.
+type GuestCart struct {
+	bun.BaseModel `bun:"table:guest_carts"`
+	Token         string    `bun:"token,pk"`
+	CreatedAt     time.Time `bun:"created_at,nullzero,default:current_timestamp"`
+	UpdatedAt     time.Time `bun:"updated_at,nullzero"`
+}
+
+// GuestCartItem is a book reserved in a guest cart until ExpiresAt.
+type GuestCartItem struct {
+	bun.BaseModel `bun:"table:guest_cart_items,alias:guest_cart_item"`
+	Token         string `bun:",pk"`
+	BookID        int    `bun:",pk"`
+	Quantity      int
+	ReservedAt    time.Time `bun:",nullzero"`
+	ExpiresAt     time.Time
+	Book          *Book `bun:"rel:belongs-to,join:book_id=id"`
+}
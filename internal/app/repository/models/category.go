@@ -10,6 +10,13 @@ type Category struct {
 	bun.BaseModel `bun:"table:categories"`
 	ID            int `bun:",pk,autoincrement"`
 	Name          string
-	CreatedAt     time.Time `bun:",nullzero"`
-	UpdatedAt     time.Time `bun:",nullzero"`
+	// ParentID is NULL for the root categories
+	ParentID     int `bun:",nullzero"`
+	Slug         string
+	Description  string
+	DisplayOrder int
+	// BookCount is the number of books in stock in the category, it's only selected
+	BookCount int       `bun:",scanonly"`
+	CreatedAt time.Time `bun:",nullzero"`
+	UpdatedAt time.Time `bun:",nullzero"`
 }
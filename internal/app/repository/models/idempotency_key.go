@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKey struct {
+	bun.BaseModel `bun:"table:idempotency_keys"`
+	UserID        int    `bun:",pk"`
+	Key           string `bun:",pk"`
+	RequestHash   string
+	StatusCode    int       `bun:",nullzero"`
+	Response      []byte    `bun:",nullzero"`
+	CreatedAt     time.Time `bun:",nullzero"`
+	CompletedAt   time.Time `bun:",nullzero"`
+}
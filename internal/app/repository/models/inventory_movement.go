@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type InventoryMovement struct {
+	bun.BaseModel `bun:"table:inventory_movements,alias:inventory_movement"`
+	ID            int `bun:",pk,autoincrement"`
+	BookID        int
+	Kind          string
+	Quantity      int
+	Reason        string
+	ActorID       int       `bun:",nullzero"`
+	CreatedAt     time.Time `bun:",nullzero"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type Order struct {
+	bun.BaseModel `bun:"table:orders"`
+	ID            int `bun:",pk,autoincrement"`
+	UserID        int
+	Total         int
+	Status        string
+	PaymentID     string            `bun:",nullzero"`
+	RefundedAt    time.Time         `bun:",nullzero"`
+	Items         []OrderItem       `bun:"rel:has-many,join:id=order_id"`
+	History       []OrderTransition `bun:"rel:has-many,join:id=order_id"`
+	CreatedAt     time.Time         `bun:",nullzero"`
+	UpdatedAt     time.Time         `bun:",nullzero"`
+}
+
+type OrderItem struct {
+	bun.BaseModel `bun:"table:order_items"`
+	ID            int `bun:",pk,autoincrement"`
+	OrderID       int
+	BookID        int `bun:",nullzero"`
+	Title         string
+	Price         int
+	Quantity      int
+}
+
+type OrderTransition struct {
+	bun.BaseModel `bun:"table:order_status_history"`
+	ID            int `bun:",pk,autoincrement"`
+	OrderID       int
+	FromStatus    string
+	ToStatus      string
+	ActorID       int
+	CreatedAt     time.Time `bun:",nullzero"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type BookSubscription struct {
+	bun.BaseModel `bun:"table:book_subscriptions,alias:book_subscription"`
+	UserID        int       `bun:",pk"`
+	BookID        int       `bun:",pk"`
+	CreatedAt     time.Time `bun:",nullzero"`
+}
+
+type StockNotification struct {
+	bun.BaseModel `bun:"table:stock_notifications,alias:stock_notification"`
+	ID            int `bun:",pk,autoincrement"`
+	UserID        int
+	BookID        int
+	Attempts      int
+	LastError     string    `bun:",nullzero"`
+	CreatedAt     time.Time `bun:",nullzero"`
+	SentAt        time.Time `bun:",nullzero"`
+	ClaimedAt     time.Time `bun:",nullzero"`
+	User          *User     `bun:"rel:belongs-to,join:user_id=id"`
+	Book          *Book     `bun:"rel:belongs-to,join:book_id=id"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type Wishlist struct {
+	bun.BaseModel `bun:"table:wishlists,alias:wishlist"`
+	ID            int `bun:",pk,autoincrement"`
+	UserID        int
+	Name          string
+	Items         []WishlistItem `bun:"rel:has-many,join:id=wishlist_id"`
+	CreatedAt     time.Time      `bun:",nullzero"`
+	UpdatedAt     time.Time      `bun:",nullzero"`
+}
+
+type WishlistItem struct {
+	bun.BaseModel `bun:"table:wishlist_items,alias:wishlist_item"`
+	WishlistID    int       `bun:",pk"`
+	BookID        int       `bun:",pk"`
+	AddedAt       time.Time `bun:",nullzero"`
+	Book          *Book     `bun:"rel:belongs-to,join:book_id=id"`
+}
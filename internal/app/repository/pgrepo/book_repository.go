@@ -6,12 +6,15 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/repository/models"
 	"toptal/internal/pkg/pg"
 
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
 type BookRepository struct {
@@ -23,14 +26,33 @@ func NewBookRepository(db *pg.DB) *BookRepository {
 	return &BookRepository{db: db}
 }
 
-// Create creates a new book
+// Create creates a new book with its categories, its initial stock is the first restock in the inventory ledger
 func (r *BookRepository) CreateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
 	dbBook := domainToBook(book)
 
 	var insertedBook models.Book
-	err := r.db.NewInsert().Model(&dbBook).Returning("*").Scan(ctx, &insertedBook)
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		err := tx.NewInsert().Model(&dbBook).Returning("*").Scan(ctx, &insertedBook)
+		if err != nil {
+			return fmt.Errorf("failed to insert a book: %w", err)
+		}
+		insertedBook.CategoryIDs, err = setBookCategories(ctx, tx, insertedBook.ID, book.CategoryIDs())
+		if err != nil {
+			return err
+		}
+		if insertedBook.Stock == 0 {
+			return nil
+		}
+
+		movements, err := stockMovements(map[int]int{insertedBook.ID: insertedBook.Stock}, stockMovement{kind: domain.MovementRestock, reason: "initial stock"})
+		if err != nil {
+			return err
+		}
+
+		return recordMovements(ctx, tx, movements)
+	}, r.db.DB)
 	if err != nil {
-		return domain.Book{}, fmt.Errorf("failed to insert a book: %w", err)
+		return domain.Book{}, err
 	}
 
 	domainBook, err := bookToDomain(insertedBook)
@@ -44,7 +66,7 @@ func (r *BookRepository) CreateBook(ctx context.Context, book domain.Book) (doma
 // GetByID retrieves a book by ID
 func (r *BookRepository) GetBook(ctx context.Context, id int) (domain.Book, error) {
 	var book models.Book
-	err := r.db.NewSelect().Model(&book).Where("id = ?", id).Scan(ctx)
+	err := selectBooks(r.db.NewSelect().Model(&book)).Where("id = ?", id).Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Book{}, domain.ErrNotFound
@@ -60,20 +82,28 @@ func (r *BookRepository) GetBook(ctx context.Context, id int) (domain.Book, erro
 	return domainBook, nil
 }
 
-// Update updates an existing book
+// Update updates an existing book and replaces its categories
 func (r *BookRepository) UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error) {
 	dbBook := domainToBook(book)
 	dbBook.UpdatedAt = time.Now()
 
 	var updatedBook models.Book
-	err := r.db.NewUpdate().
-		Model(&dbBook).
-		Where("id = ?", dbBook.ID).
-		ExcludeColumn("created_at", "stock").
-		Returning("*").
-		Scan(ctx, &updatedBook)
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(&dbBook).
+			Where("id = ?", dbBook.ID).
+			ExcludeColumn("created_at", "stock", "archived_at").
+			Returning("*").
+			Scan(ctx, &updatedBook)
+		if err != nil {
+			return fmt.Errorf("failed to update a book: %w", err)
+		}
+
+		updatedBook.CategoryIDs, err = setBookCategories(ctx, tx, updatedBook.ID, book.CategoryIDs())
+		return err
+	}, r.db.DB)
 	if err != nil {
-		return domain.Book{}, fmt.Errorf("failed to update a book: %w", err)
+		return domain.Book{}, err
 	}
 
 	domainBook, err := bookToDomain(updatedBook)
@@ -94,34 +124,336 @@ func (r *BookRepository) DeleteBook(ctx context.Context, id int) error {
 	return nil
 }
 
-func (r *BookRepository) GetBooks(ctx context.Context, categoryIDs []int, limit, offset int) ([]domain.Book, error) {
+// GetBooks retrieves a page of the books matching the filter, a search query matches the titles and authors
+// and ranks the books by relevance
+func (r *BookRepository) GetBooks(ctx context.Context, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error) {
 	var books []models.Book
-	query := r.db.NewSelect().Model(&books)
-	query.Where("stock > 0")
-	if len(categoryIDs) > 0 {
-		query.Where("category_id IN (?)", bun.In(categoryIDs))
-	}
-	if limit > 0 {
-		query.Limit(limit)
+	query := selectBooks(r.db.NewSelect().Model(&books))
+	if filter.Sort() == domain.BookSortRelevance {
+		query.ColumnExpr("ts_rank(search, websearch_to_tsquery('english', ?)) AS rank", filter.Query())
 	}
-	if offset > 0 {
-		query.Offset(offset)
+	filterBooks(query, filter)
+	if cursor, ok := page.Cursor(); ok {
+		err := booksAfter(query, filter, cursor)
+		if err != nil {
+			return domain.Page[domain.Book]{}, err
+		}
+	} else if page.Offset() > 0 {
+		query.Offset(page.Offset())
 	}
-	query.Order("id")
+	orderBooks(query, filter)
+	// the extra book tells whether there is a next page
+	query.Limit(page.Size() + 1)
 	err := query.Scan(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get books: %w", err)
+		return domain.Page[domain.Book]{}, fmt.Errorf("failed to get books: %w", err)
+	}
+
+	var nextCursor string
+	if len(books) > page.Size() {
+		books = books[:page.Size()]
+		cursor, err := bookCursor(books[len(books)-1], filter.Sort())
+		if err != nil {
+			return domain.Page[domain.Book]{}, err
+		}
+		nextCursor = cursor.Encode()
+	}
+
+	var total int
+	if page.WithTotal() {
+		countQuery := r.db.NewSelect().Model((*models.Book)(nil))
+		filterBooks(countQuery, filter)
+		total, err = countQuery.Count(ctx)
+		if err != nil {
+			return domain.Page[domain.Book]{}, fmt.Errorf("failed to count books: %w", err)
+		}
 	}
 
 	domainBooks := make([]domain.Book, len(books))
 	for i, book := range books {
 		domainBook, err := bookToDomain(book)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create domain book: %w", err)
+			return domain.Page[domain.Book]{}, fmt.Errorf("failed to create domain book: %w", err)
 		}
 
 		domainBooks[i] = domainBook
 	}
 
-	return domainBooks, nil
+	return domain.NewPage(domain.NewPageData[domain.Book]{
+		Items:      domainBooks,
+		NextCursor: nextCursor,
+		Total:      total,
+		Counted:    page.WithTotal(),
+	})
+}
+
+// selectBooks selects the books with the IDs of all their categories
+func selectBooks(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.ColumnExpr("?TableAlias.*").
+		ColumnExpr("ARRAY(SELECT bc.category_id FROM book_categories AS bc WHERE bc.book_id = ?TableAlias.id ORDER BY bc.category_id) AS category_ids")
+}
+
+// setBookCategories replaces the categories of the book and returns their IDs
+func setBookCategories(ctx context.Context, tx bun.Tx, bookID int, categoryIDs []int) ([]int, error) {
+	_, err := tx.NewDelete().
+		Model((*models.BookCategory)(nil)).
+		Where("book_id = ?", bookID).
+		Where("category_id NOT IN (?)", bun.In(categoryIDs)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove book categories: %w", err)
+	}
+
+	bookCategories := make([]models.BookCategory, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		bookCategories = append(bookCategories, models.BookCategory{BookID: bookID, CategoryID: categoryID})
+	}
+	_, err = tx.NewInsert().Model(&bookCategories).On("CONFLICT DO NOTHING").Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add book categories: %w", err)
+	}
+
+	return categoryIDs, nil
+}
+
+// filterBooks narrows the query down to the books matching the filter
+func filterBooks(query *bun.SelectQuery, filter domain.BookFilter) {
+	query.Where("archived_at IS NULL")
+	if !filter.IncludeSoldOut() {
+		query.Where("stock > 0")
+	}
+	if len(filter.CategoryIDs()) > 0 && filter.IncludeSubcategories() {
+		query.Where(`EXISTS (SELECT 1 FROM book_categories AS bc WHERE bc.book_id = ?TableAlias.id AND bc.category_id IN (
+			WITH RECURSIVE subcategories AS (
+				SELECT id FROM categories WHERE id IN (?)
+				UNION
+				SELECT c.id FROM categories AS c JOIN subcategories AS s ON c.parent_id = s.id
+			)
+			SELECT id FROM subcategories))`, bun.In(filter.CategoryIDs()))
+	} else if len(filter.CategoryIDs()) > 0 {
+		query.Where("EXISTS (SELECT 1 FROM book_categories AS bc WHERE bc.book_id = ?TableAlias.id AND bc.category_id IN (?))", bun.In(filter.CategoryIDs()))
+	}
+	if filter.Author() != "" {
+		query.Where("lower(author) = lower(?)", filter.Author())
+	}
+	if filter.AuthorContains() != "" {
+		query.Where("author ILIKE ?", "%"+escapeLike(filter.AuthorContains())+"%")
+	}
+	if filter.YearMin() > 0 {
+		query.Where("year >= ?", filter.YearMin())
+	}
+	if filter.YearMax() > 0 {
+		query.Where("year <= ?", filter.YearMax())
+	}
+	if filter.PriceMin() > 0 {
+		query.Where("price >= ?", filter.PriceMin())
+	}
+	if filter.PriceMax() > 0 {
+		query.Where("price <= ?", filter.PriceMax())
+	}
+	if filter.Query() != "" {
+		query.Where("search @@ websearch_to_tsquery('english', ?)", filter.Query())
+	}
+}
+
+// booksAfter narrows the query down to the books that come after the cursor in the order of the filter
+func booksAfter(query *bun.SelectQuery, filter domain.BookFilter, cursor domain.Cursor) error {
+	sort := filter.Sort()
+	if cursor.Sort() != string(sort) {
+		return fmt.Errorf("%w: it belongs to the %s sort", domain.ErrInvalidCursor, cursor.Sort())
+	}
+
+	switch sort {
+	case domain.BookSortPriceAsc, domain.BookSortPriceDesc, domain.BookSortYearAsc, domain.BookSortYearDesc:
+		key, err := strconv.Atoi(cursor.Key())
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+		}
+		column, direction := "price", ">"
+		if sort == domain.BookSortYearAsc || sort == domain.BookSortYearDesc {
+			column = "year"
+		}
+		if sort == domain.BookSortPriceDesc || sort == domain.BookSortYearDesc {
+			direction = "<"
+		}
+		query.Where("(?, id) "+direction+" (?, ?)", bun.Ident(column), key, cursor.ID())
+	case domain.BookSortTitle:
+		query.Where("(title, id) > (?, ?)", cursor.Key(), cursor.ID())
+	case domain.BookSortNewest:
+		key, err := time.Parse(time.RFC3339Nano, cursor.Key())
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+		}
+		query.Where("(created_at, id) < (?, ?)", key, cursor.ID())
+	case domain.BookSortRelevance:
+		key, err := strconv.ParseFloat(cursor.Key(), 32)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+		}
+		query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("ts_rank(search, websearch_to_tsquery('english', ?)) < ?::real", filter.Query(), key).
+				WhereOr("ts_rank(search, websearch_to_tsquery('english', ?)) = ?::real AND id > ?", filter.Query(), key, cursor.ID())
+		})
+	default:
+		query.Where("id > ?", cursor.ID())
+	}
+
+	return nil
+}
+
+// bookCursor points at the book in the listing sorted by sort
+func bookCursor(book models.Book, sort domain.BookSort) (domain.Cursor, error) {
+	var key string
+	switch sort {
+	case domain.BookSortPriceAsc, domain.BookSortPriceDesc:
+		key = strconv.Itoa(book.Price)
+	case domain.BookSortYearAsc, domain.BookSortYearDesc:
+		key = strconv.Itoa(book.Year)
+	case domain.BookSortTitle:
+		key = book.Title
+	case domain.BookSortNewest:
+		key = book.CreatedAt.Format(time.RFC3339Nano)
+	case domain.BookSortRelevance:
+		key = strconv.FormatFloat(float64(book.Rank), 'g', -1, 32)
+	}
+
+	cursor, err := domain.NewCursor(string(sort), key, book.ID)
+	if err != nil {
+		return domain.Cursor{}, fmt.Errorf("failed to create cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// suggestSimilarityThreshold is the lowest trigram word similarity of a suggestion, low enough for typos like "Tolkein"
+const suggestSimilarityThreshold = 0.4
+
+// bookSuggestion is a title or an author matched by SuggestBooks
+type bookSuggestion struct {
+	Text  string  `bun:"text"`
+	Kind  string  `bun:"kind"`
+	Score float64 `bun:"score"`
+}
+
+// SuggestBooks retrieves the titles and authors of the books in stock that resemble the prefix the most.
+// The trigram indexes serve the word similarity operator, so it stays fast enough to be called on every keystroke.
+func (r *BookRepository) SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error) {
+	var suggestions []bookSuggestion
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		_, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL pg_trgm.word_similarity_threshold = %v", suggestSimilarityThreshold))
+		if err != nil {
+			return fmt.Errorf("failed to set similarity threshold: %w", err)
+		}
+
+		return tx.NewRaw(`SELECT text, kind, score FROM (
+				SELECT title AS text, ?0 AS kind, word_similarity(?2, title) AS score FROM ?4 WHERE ?2 <% title AND stock > 0 AND archived_at IS NULL
+				UNION
+				SELECT author, ?1, word_similarity(?2, author) FROM ?4 WHERE ?2 <% author AND stock > 0 AND archived_at IS NULL
+			) AS suggestions
+			ORDER BY score DESC, text, kind
+			LIMIT ?3`,
+			domain.SuggestionTitle, domain.SuggestionAuthor, prefix, limit, bun.Ident("books")).
+			Scan(ctx, &suggestions)
+	}, r.db.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest books: %w", err)
+	}
+
+	domainSuggestions := make([]domain.BookSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		domainSuggestion, err := domain.NewBookSuggestion(domain.NewBookSuggestionData{
+			Text:  suggestion.Text,
+			Kind:  domain.SuggestionKind(suggestion.Kind),
+			Score: suggestion.Score,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain book suggestion: %w", err)
+		}
+		domainSuggestions = append(domainSuggestions, domainSuggestion)
+	}
+
+	return domainSuggestions, nil
+}
+
+type bookFacet struct {
+	Facet string `bun:"facet"`
+	Key   int    `bun:"key"`
+	Name  string `bun:"name"`
+	Count int    `bun:"count"`
+}
+
+// GetBookFacets counts the books matching the filter per category, decade and price bucket.
+// The books are filtered once and the three facets are aggregated in the same statement.
+func (r *BookRepository) GetBookFacets(ctx context.Context, filter domain.BookFilter) (domain.BookFacets, error) {
+	filtered := r.db.NewSelect().Model((*models.Book)(nil)).Column("id", "year", "price")
+	filterBooks(filtered, filter)
+
+	var facets []bookFacet
+	err := r.db.NewRaw(`WITH filtered AS (?0)
+		SELECT 'category' AS facet, c.id AS key, c.name, count(*) AS count
+		FROM filtered AS f
+		JOIN ?1 AS bc ON bc.book_id = f.id
+		JOIN ?2 AS c ON c.id = bc.category_id
+		GROUP BY c.id, c.name
+		UNION ALL
+		SELECT 'decade', year / 10 * 10, '', count(*) FROM filtered GROUP BY 2
+		UNION ALL
+		SELECT 'price', width_bucket(price, ?3::integer[]), '', count(*) FROM filtered GROUP BY 2
+		ORDER BY facet, key`,
+		filtered, bun.Ident("book_categories"), bun.Ident("categories"), pgdialect.Array(domain.PriceBucketBounds())).
+		Scan(ctx, &facets)
+	if err != nil {
+		return domain.BookFacets{}, fmt.Errorf("failed to count book facets: %w", err)
+	}
+
+	var data domain.NewBookFacetsData
+	for _, facet := range facets {
+		switch facet.Facet {
+		case "category":
+			data.Categories = append(data.Categories, domain.CategoryFacetData{CategoryID: facet.Key, Name: facet.Name, Count: facet.Count})
+		case "decade":
+			data.Decades = append(data.Decades, domain.DecadeFacetData{Decade: facet.Key, Count: facet.Count})
+		case "price":
+			data.Prices = append(data.Prices, domain.PriceFacetData{Bucket: facet.Key, Count: facet.Count})
+		}
+	}
+
+	domainFacets, err := domain.NewBookFacets(data)
+	if err != nil {
+		return domain.BookFacets{}, fmt.Errorf("failed to create domain book facets: %w", err)
+	}
+
+	return domainFacets, nil
+}
+
+// orderBooks orders the books by the sort of the filter, the ID breaks the ties in the direction of the sort
+// so that the (column, id) indexes serve the listings
+func orderBooks(query *bun.SelectQuery, filter domain.BookFilter) {
+	switch filter.Sort() {
+	case domain.BookSortPriceAsc:
+		query.Order("price ASC", "id ASC")
+	case domain.BookSortPriceDesc:
+		query.Order("price DESC", "id DESC")
+	case domain.BookSortYearAsc:
+		query.Order("year ASC", "id ASC")
+	case domain.BookSortYearDesc:
+		query.Order("year DESC", "id DESC")
+	case domain.BookSortTitle:
+		query.Order("title ASC", "id ASC")
+	case domain.BookSortNewest:
+		query.Order("created_at DESC", "id DESC")
+	case domain.BookSortRelevance:
+		query.OrderExpr("ts_rank(search, websearch_to_tsquery('english', ?)) DESC", filter.Query()).Order("id ASC")
+	default:
+		query.Order("id ASC")
+	}
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike makes the text match itself literally in a LIKE pattern
+func escapeLike(text string) string {
+	return likeEscaper.Replace(text)
 }
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
@@ -24,97 +26,229 @@ func NewCartRepository(db *pg.DB) *CartRepository {
 }
 
 func (r CartRepository) GetCart(ctx context.Context, userID int) (domain.Cart, error) {
-	var cart models.Cart
-	err := r.db.NewSelect().Model(&cart).Where("user_id = ?", userID).Scan(ctx)
+	domainCart, err := loadCart(ctx, r.db, userID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.Cart{}, domain.ErrNotFound
-		}
-		return domain.Cart{}, fmt.Errorf("failed to get cart: %w", err)
+		return domain.Cart{}, err
+	}
+	if !domainCart.HasBooks() {
+		return domain.Cart{}, domain.ErrNotFound
 	}
 
-	domainCart, err := cartToDomain(cart)
+	return domainCart, nil
+}
+
+// GetCartItems returns the books in the user's cart ordered by ID
+func (r CartRepository) GetCartItems(ctx context.Context, userID int) ([]domain.CartItem, error) {
+	var cartItems []models.CartItem
+	err := r.db.NewSelect().Model(&cartItems).
+		Relation("Book").
+		Where("cart_item.user_id = ?", userID).
+		Order("cart_item.book_id").
+		Scan(ctx)
 	if err != nil {
-		return domain.Cart{}, fmt.Errorf("failed to create domain cart: %w", err)
+		return nil, fmt.Errorf("failed to get cart items: %w", err)
 	}
 
-	return domainCart, nil
+	items := make([]domain.CartItem, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		item, err := cartItemToDomain(cartItem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain cart item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
 }
 
+// UpdateCartAndStocks replaces the books in the cart, see UpdateCart
 func (r CartRepository) UpdateCartAndStocks(ctx context.Context, cart domain.Cart) error {
+	err := r.UpdateCart(ctx, cart.UserID(), func(current *domain.Cart) error {
+		*current = cart
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update cart and stock: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateCart locks the user's cart, applies updateFn to it and reserves or releases
+// the stock of just the copies updateFn has added or removed. Every book whose quantity has grown is reserved
+// for domain.CartReservationTTL again, the other books keep their reservation. An emptied cart is deleted.
+func (r CartRepository) UpdateCart(ctx context.Context, userID int, updateFn func(cart *domain.Cart) error) error {
 	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
-		oldCart, err := r.GetCart(ctx, cart.UserID())
-		if err != nil && !errors.Is(err, domain.ErrNotFound) {
-			return fmt.Errorf("failed to get cart: %w", err)
-		}
+		return updateUserCart(ctx, tx, userID, updateFn)
+	}, r.db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
 
-		if cart.Equal(oldCart) {
-			return nil
-		}
+	return nil
+}
 
-		var cartAdd, cartRemove, cartUnion domain.Cart
-		if oldCart.HasBooks() {
-			cartAdd = cart.Diff(oldCart)
-			cartRemove = oldCart.Diff(cart)
-			cartUnion = oldCart.Merge(cart)
-		} else {
-			cartAdd = cart
-			cartUnion = cart
-		}
+// updateUserCart does the work of UpdateCart inside the transaction tx
+func updateUserCart(ctx context.Context, tx bun.Tx, userID int, updateFn func(cart *domain.Cart) error) error {
+	now := time.Now()
+
+	// creating the cart row locks it for the rest of the transaction
+	dbCart := models.Cart{UserID: userID, UpdatedAt: now}
+	_, err := tx.NewInsert().Model(&dbCart).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock cart: %w", err)
+	}
+
+	oldCart, err := loadCart(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	cart := oldCart.Clone()
+	err = updateFn(&cart)
+	if err != nil {
+		return err
+	}
+
+	changes, err := reserveStocks(ctx, tx, oldCart, cart)
+	if err != nil {
+		return err
+	}
 
-		ok, err := r.CheckStocks(ctx, cartAdd)
+	if len(changes.grown) > 0 {
+		items := make([]models.CartItem, 0, len(changes.grown))
+		for _, bookID := range changes.grown {
+			items = append(items, models.CartItem{
+				UserID:     userID,
+				BookID:     bookID,
+				Quantity:   cart.Quantity(bookID),
+				ReservedAt: now,
+				ExpiresAt:  now.Add(domain.CartReservationTTL),
+			})
+		}
+		_, err = tx.NewInsert().Model(&items).
+			On("CONFLICT (user_id, book_id) DO UPDATE").
+			Set("quantity = EXCLUDED.quantity").
+			Set("reserved_at = EXCLUDED.reserved_at").
+			Set("expires_at = EXCLUDED.expires_at").
+			Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to check stocks: %w", err)
+			return fmt.Errorf("failed to add cart items: %w", err)
 		}
-		if !ok {
-			return slugerrors.NewBadRequestError("some books are out of stock", "out-of-stock")
+	}
+	// the books with fewer copies keep their reservation time
+	for _, bookID := range changes.shrunk {
+		_, err := tx.NewUpdate().Model((*models.CartItem)(nil)).
+			Set("quantity = ?", cart.Quantity(bookID)).
+			Where("user_id = ?", userID).
+			Where("book_id = ?", bookID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update cart item: %w", err)
+		}
+	}
+	if len(changes.removed) > 0 {
+		_, err = tx.NewDelete().Model((*models.CartItem)(nil)).
+			Where("user_id = ?", userID).
+			Where("book_id in (?)", bun.In(changes.removed)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete cart items: %w", err)
 		}
+	}
 
-		var dbStocks []models.Book //Used for locking stocks
-		if cartUnion.HasBooks() {
-			err := tx.NewRaw("SELECT id, stock FROM ? where id in (?) FOR UPDATE", bun.Ident("books"), bun.In(cartUnion.BookIDs())).Scan(ctx, &dbStocks)
-			if err != nil {
-				return fmt.Errorf("failed to lock stocks: %w", err)
-			}
+	if !cart.HasBooks() {
+		_, err := tx.NewDelete().Model((*models.Cart)(nil)).Where("user_id = ?", userID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete cart: %w", err)
 		}
+	}
 
-		if cartAdd.HasBooks() {
-			_, err := tx.NewUpdate().Model((*models.Book)(nil)).Set("stock = stock - 1").Where("id in (?)", bun.In(cartAdd.BookIDs())).Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to reduce stock: %w", err)
-			}
+	return nil
+}
+
+// cartChanges lists the books whose number of copies differs between two versions of a cart
+type cartChanges struct {
+	grown   []int
+	shrunk  []int
+	removed []int
+}
+
+// reserveStocks takes the copies that were added to the cart from the stock and puts the removed copies back.
+// It fails with the book-not-found or out-of-stock slug when an added book can't be reserved, archived books have no stock to reserve.
+func reserveStocks(ctx context.Context, tx bun.Tx, oldCart, cart domain.Cart) (cartChanges, error) {
+	var changes cartChanges
+	// deltas holds the number of copies to take from the stock of each changed book
+	deltas := make(map[int]int)
+	for _, bookID := range cart.BookIDs() {
+		delta := cart.Quantity(bookID) - oldCart.Quantity(bookID)
+		switch {
+		case delta > 0:
+			changes.grown = append(changes.grown, bookID)
+		case delta < 0:
+			changes.shrunk = append(changes.shrunk, bookID)
+		default:
+			continue
 		}
-		if cartRemove.HasBooks() {
-			_, err := tx.NewUpdate().Model((*models.Book)(nil)).Set("stock = stock + 1").Where("id in (?)", bun.In(cartRemove.BookIDs())).Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to add stock: %w", err)
-			}
+		deltas[bookID] = delta
+	}
+	for _, bookID := range oldCart.BookIDs() {
+		if !cart.HasBook(bookID) {
+			deltas[bookID] = -oldCart.Quantity(bookID)
+			changes.removed = append(changes.removed, bookID)
 		}
+	}
+	if len(deltas) == 0 {
+		return changes, nil
+	}
 
-		dbCart := domainToCart(cart)
-		dbCart.UpdatedAt = time.Now()
+	stocks, err := lockReservableStocks(ctx, tx, slices.Collect(maps.Keys(deltas)))
+	if err != nil {
+		return cartChanges{}, err
+	}
+	for _, bookID := range changes.grown {
+		stock, ok := stocks[bookID]
+		if !ok {
+			return cartChanges{}, slugerrors.NewNotFoundError("book not found", "book-not-found")
+		}
+		if stock < deltas[bookID] {
+			return cartChanges{}, slugerrors.NewBadRequestError("some books are out of stock", "out-of-stock")
+		}
+	}
 
-		err = tx.NewInsert().Model(&dbCart).
-			On("CONFLICT (user_id) DO UPDATE").
-			Set("book_ids = EXCLUDED.book_ids").
-			Set("updated_at = EXCLUDED.updated_at").
-			Scan(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to update cart: %w", err)
+	reserved := make(map[int]int, len(deltas))
+	released := make(map[int]int, len(deltas))
+	for bookID, delta := range deltas {
+		if delta > 0 {
+			reserved[bookID] = delta
+		} else {
+			released[bookID] = -delta
 		}
+	}
+	reason := "cart updated"
+	if cart.IsGuest() {
+		reason = "guest cart updated"
+	}
 
-		return nil
-	}, r.db.DB)
+	err = changeStocks(ctx, tx, released, stockMovement{kind: domain.MovementRelease, reason: reason, actorID: cart.UserID()})
 	if err != nil {
-		return fmt.Errorf("failed to update cart and stock: %w", err)
+		return cartChanges{}, err
+	}
+	err = changeStocks(ctx, tx, reserved, stockMovement{kind: domain.MovementReservation, reason: reason, actorID: cart.UserID()})
+	if err != nil {
+		return cartChanges{}, err
 	}
 
-	return nil
+	return changes, nil
 }
 
+// CheckStocks reports whether there is enough stock left to reserve every copy in the cart
 func (r CartRepository) CheckStocks(ctx context.Context, cart domain.Cart) (bool, error) {
 	var books []models.Book
-	err := r.db.NewSelect().Model(&books).Where("id in (?)", bun.In(cart.BookIDs())).Scan(ctx)
+	err := r.db.NewSelect().Model(&books).Where("id in (?)", bun.In(cart.BookIDs())).Where("archived_at IS NULL").Scan(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to get stocks: %w", err)
 	}
@@ -124,13 +258,19 @@ func (r CartRepository) CheckStocks(ctx context.Context, cart domain.Cart) (bool
 		stockMap[book.ID] = book.Stock
 	}
 
+	return len(unavailableBooks(stockMap, cart)) == 0, nil
+}
+
+// unavailableBooks returns the books of the cart that have fewer copies in stock than the cart asks for
+func unavailableBooks(stocks map[int]int, cart domain.Cart) []int {
+	var unavailable []int
 	for _, bookID := range cart.BookIDs() {
-		if stockMap[bookID] == 0 {
-			return false, nil
+		if stocks[bookID] < cart.Quantity(bookID) {
+			unavailable = append(unavailable, bookID)
 		}
 	}
 
-	return true, nil
+	return unavailable
 }
 
 // DeleteCart deletes a cart
@@ -143,32 +283,183 @@ func (r CartRepository) DeleteCart(ctx context.Context, userID int) error {
 	return nil
 }
 
-func (r CartRepository) CleanExpiredCarts(ctx context.Context, ttl time.Duration) error {
+// ReleaseCart deletes a cart and puts its books back in stock
+func (r CartRepository) ReleaseCart(ctx context.Context, userID int) error {
 	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
-		var expiredCarts []models.Cart
-		err := tx.NewSelect().Model(&expiredCarts).Where("updated_at < ?", time.Now().Add(-ttl)).Scan(ctx)
+		var cart models.Cart
+		err := tx.NewSelect().Model(&cart).Where("user_id = ?", userID).For("UPDATE").Scan(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get expired carts: %w", err)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock cart: %w", err)
+		}
+
+		domainCart, err := loadCart(ctx, tx, userID)
+		if err != nil {
+			return err
 		}
 
-		for _, cart := range expiredCarts {
-			for _, bookID := range cart.BookIDs {
-				_, err := tx.NewUpdate().Model((*models.Book)(nil)).Set("stock = stock + 1").Where("id = ?", bookID).Exec(ctx)
-				if err != nil {
-					return fmt.Errorf("failed to return stock: %w", err)
-				}
+		if domainCart.HasBooks() {
+			_, err := lockStocks(ctx, tx, domainCart.BookIDs())
+			if err != nil {
+				return err
 			}
-			_, err := tx.NewDelete().Model(&cart).Where("user_id = ?", cart.UserID).Exec(ctx)
+
+			err = changeStocks(ctx, tx, cartQuantities(domainCart), stockMovement{kind: domain.MovementRelease, reason: "cart released", actorID: userID})
 			if err != nil {
-				return fmt.Errorf("failed to delete cart: %w", err)
+				return err
 			}
 		}
 
+		_, err = tx.NewDelete().Model((*models.Cart)(nil)).Where("user_id = ?", userID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete cart: %w", err)
+		}
+
 		return nil
 	}, r.db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to release cart: %w", err)
+	}
+
+	return nil
+}
+
+// CleanExpiredCarts releases every user and guest cart item whose reservation has expired
+// and deletes the carts that are left empty
+func (r CartRepository) CleanExpiredCarts(ctx context.Context) error {
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		now := time.Now()
+
+		var carts []models.Cart
+		// carts that are being changed or checked out right now are locked and skipped
+		err := tx.NewSelect().Model(&carts).
+			Where("user_id IN (?)", tx.NewSelect().Model((*models.CartItem)(nil)).Column("user_id").Where("expires_at < ?", now)).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get expired carts: %w", err)
+		}
+
+		for _, cart := range carts {
+			var items []models.CartItem
+			err := tx.NewSelect().Model(&items).
+				Where("user_id = ?", cart.UserID).
+				Where("expires_at < ?", now).
+				Scan(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get expired cart items: %w", err)
+			}
+			if len(items) == 0 {
+				continue
+			}
+
+			bookIDs := make([]int, 0, len(items))
+			quantities := make(map[int]int, len(items))
+			for _, item := range items {
+				bookIDs = append(bookIDs, item.BookID)
+				quantities[item.BookID] = item.Quantity
+			}
+
+			_, err = lockStocks(ctx, tx, bookIDs)
+			if err != nil {
+				return err
+			}
+			err = changeStocks(ctx, tx, quantities, stockMovement{kind: domain.MovementRelease, reason: "reservation expired"})
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.NewDelete().Model((*models.CartItem)(nil)).
+				Where("user_id = ?", cart.UserID).
+				Where("book_id in (?)", bun.In(bookIDs)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete expired cart items: %w", err)
+			}
+
+			_, err = tx.NewDelete().Model((*models.Cart)(nil)).
+				Where("user_id = ?", cart.UserID).
+				Where("NOT EXISTS (?)", tx.NewSelect().Model((*models.CartItem)(nil)).ColumnExpr("1").Where("user_id = ?", cart.UserID)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete empty cart: %w", err)
+			}
+		}
+
+		return cleanExpiredGuestCarts(ctx, tx, now)
+	}, r.db.DB)
 	if err != nil {
 		return fmt.Errorf("failed to clean expired carts: %w", err)
 	}
 
 	return nil
 }
+
+// loadCart returns the user's cart with the books in the order they were added, the cart is empty when the user has none
+func loadCart(ctx context.Context, db bun.IDB, userID int) (domain.Cart, error) {
+	var items []models.CartItem
+	err := db.NewSelect().Model(&items).
+		Where("user_id = ?", userID).
+		Order("reserved_at", "book_id").
+		Scan(ctx)
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to get cart books: %w", err)
+	}
+
+	cart, err := domain.NewEmptyCart(userID)
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to create domain cart: %w", err)
+	}
+	for _, item := range items {
+		err := cart.SetQuantity(item.BookID, item.Quantity)
+		if err != nil {
+			return domain.Cart{}, fmt.Errorf("failed to create domain cart: %w", err)
+		}
+	}
+
+	return cart, nil
+}
+
+// cartQuantities returns the number of copies in the cart by book ID
+func cartQuantities(cart domain.Cart) map[int]int {
+	quantities := make(map[int]int, len(cart.BookIDs()))
+	for _, bookID := range cart.BookIDs() {
+		quantities[bookID] = cart.Quantity(bookID)
+	}
+
+	return quantities
+}
+
+// lockStocks locks the stock of the books until the end of the transaction and returns it by book ID
+func lockStocks(ctx context.Context, tx bun.Tx, bookIDs []int) (map[int]int, error) {
+	var dbStocks []models.Book
+	err := tx.NewRaw("SELECT id, stock FROM ? where id in (?) FOR UPDATE", bun.Ident("books"), bun.In(bookIDs)).Scan(ctx, &dbStocks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock stocks: %w", err)
+	}
+
+	stocks := make(map[int]int, len(dbStocks))
+	for _, book := range dbStocks {
+		stocks[book.ID] = book.Stock
+	}
+
+	return stocks, nil
+}
+
+// lockReservableStocks locks the stock of the books like lockStocks, but the archived books have no copies left to reserve
+func lockReservableStocks(ctx context.Context, tx bun.Tx, bookIDs []int) (map[int]int, error) {
+	var dbStocks []models.Book
+	err := tx.NewRaw("SELECT id, CASE WHEN archived_at IS NULL THEN stock ELSE 0 END AS stock FROM ? WHERE id IN (?) FOR UPDATE", bun.Ident("books"), bun.In(bookIDs)).Scan(ctx, &dbStocks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock stocks: %w", err)
+	}
+
+	stocks := make(map[int]int, len(dbStocks))
+	for _, book := range dbStocks {
+		stocks[book.ID] = book.Stock
+	}
+
+	return stocks, nil
+}
@@ -6,12 +6,20 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/repository/models"
 	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
 )
 
+// categorySort is the only order of the categories listing, by display order and then by ID
+const categorySort = "display_order"
+
 type CategoryRepository struct {
 	db *pg.DB
 }
@@ -21,14 +29,34 @@ func NewCategoryRepository(db *pg.DB) *CategoryRepository {
 	return &CategoryRepository{db: db}
 }
 
-// Create creates a new category
+// Create creates a new category under its parent
 func (r *CategoryRepository) CreateCategory(ctx context.Context, category domain.Category) (domain.Category, error) {
 	dbCategory := domainToCategory(category)
 
 	var insertedCategory models.Category
-	err := r.db.NewInsert().Model(&dbCategory).Returning("*").Scan(ctx, &insertedCategory)
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		err := lockCategories(ctx, tx)
+		if err != nil {
+			return err
+		}
+		err = checkCategoryParent(ctx, tx, dbCategory.ID, dbCategory.ParentID)
+		if err != nil {
+			return err
+		}
+		dbCategory.Slug, err = uniqueCategorySlug(ctx, tx, category)
+		if err != nil {
+			return err
+		}
+
+		err = tx.NewInsert().Model(&dbCategory).Returning("*").Scan(ctx, &insertedCategory)
+		if err != nil {
+			return fmt.Errorf("failed to insert a category: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
 	if err != nil {
-		return domain.Category{}, fmt.Errorf("failed to insert a category: %w", err)
+		return domain.Category{}, err
 	}
 
 	domainCategory, err := categoryToDomain(insertedCategory)
@@ -42,7 +70,26 @@ func (r *CategoryRepository) CreateCategory(ctx context.Context, category domain
 // GetByID retrieves a category by ID
 func (r *CategoryRepository) GetCategory(ctx context.Context, id int) (domain.Category, error) {
 	var category models.Category
-	err := r.db.NewSelect().Model(&category).Where("id = ?", id).Scan(ctx)
+	err := selectCategories(r.db.NewSelect().Model(&category)).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Category{}, domain.ErrNotFound
+		}
+		return domain.Category{}, fmt.Errorf("failed to get a category: %w", err)
+	}
+
+	domainCategory, err := categoryToDomain(category)
+	if err != nil {
+		return domain.Category{}, fmt.Errorf("failed to create domain category: %w", err)
+	}
+
+	return domainCategory, nil
+}
+
+// GetCategoryBySlug retrieves a category by its slug
+func (r *CategoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (domain.Category, error) {
+	var category models.Category
+	err := selectCategories(r.db.NewSelect().Model(&category)).Where("slug = ?", slug).Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Category{}, domain.ErrNotFound
@@ -58,20 +105,45 @@ func (r *CategoryRepository) GetCategory(ctx context.Context, id int) (domain.Ca
 	return domainCategory, nil
 }
 
-// Update updates an existing category
+// Update updates an existing category, a new parent moves it with its subcategories
 func (r *CategoryRepository) UpdateCategory(ctx context.Context, category domain.Category) (domain.Category, error) {
 	dbCategory := domainToCategory(category)
 	dbCategory.UpdatedAt = time.Now()
 
 	var updatedCategory models.Category
-	err := r.db.NewUpdate().
-		Model(&dbCategory).
-		Where("id = ?", dbCategory.ID).
-		ExcludeColumn("created_at").
-		Returning("*").
-		Scan(ctx, &updatedCategory)
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		err := lockCategories(ctx, tx)
+		if err != nil {
+			return err
+		}
+		err = checkCategoryParent(ctx, tx, dbCategory.ID, dbCategory.ParentID)
+		if err != nil {
+			return err
+		}
+		dbCategory.Slug, err = uniqueCategorySlug(ctx, tx, category)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model(&dbCategory).
+			Where("id = ?", dbCategory.ID).
+			ExcludeColumn("created_at").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update a category: %w", err)
+		}
+
+		// the category is selected again for its book count
+		err = selectCategories(tx.NewSelect().Model(&updatedCategory)).Where("id = ?", dbCategory.ID).Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get the updated category: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
 	if err != nil {
-		return domain.Category{}, fmt.Errorf("failed to update a category: %w", err)
+		return domain.Category{}, err
 	}
 
 	domainCategory, err := categoryToDomain(updatedCategory)
@@ -82,25 +154,270 @@ func (r *CategoryRepository) UpdateCategory(ctx context.Context, category domain
 	return domainCategory, nil
 }
 
-// Delete deletes a category by ID
-func (r *CategoryRepository) DeleteCategory(ctx context.Context, id int) error {
-	_, err := r.db.NewDelete().Model((*models.Category)(nil)).Where("id = ?", id).Exec(ctx)
+// Delete deletes a category by ID, its subcategories move up to its parent and its books are handled by the
+// policy of the deletion in the same transaction. It returns the number of books that belonged to the category.
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, id int, deletion domain.CategoryDeletion) (int, error) {
+	var affected int
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		err := lockCategories(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		exists, err := tx.NewSelect().Model((*models.Category)(nil)).Where("id = ?", id).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get a category: %w", err)
+		}
+		if !exists {
+			return domain.ErrNotFound
+		}
+
+		if deletion.Policy() == domain.CategoryDeleteArchive {
+			err = lockCategoryCarts(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the books are locked so that they can't join the category while it's being deleted
+		var bookIDs []int
+		err = tx.NewRaw(`SELECT id FROM ?0
+			WHERE category_id = ?1 OR id IN (SELECT book_id FROM ?2 WHERE category_id = ?1)
+			ORDER BY id
+			FOR UPDATE`,
+			bun.Ident("books"), id, bun.Ident("book_categories")).
+			Scan(ctx, &bookIDs)
+		if err != nil {
+			return fmt.Errorf("failed to lock the books of the category: %w", err)
+		}
+		affected = len(bookIDs)
+
+		if affected > 0 {
+			switch deletion.Policy() {
+			case domain.CategoryDeleteReassign:
+				err = reassignBooks(ctx, tx, id, deletion.ReassignTo())
+			case domain.CategoryDeleteArchive:
+				err = archiveBooks(ctx, tx, id, bookIDs)
+			default:
+				err = fmt.Errorf("%w: %d books belong to it", domain.ErrCategoryNotEmpty, affected)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*models.Category)(nil)).
+			Set("parent_id = (SELECT parent_id FROM categories WHERE id = ?)", id).
+			Set("updated_at = now()").
+			Where("parent_id = ?", id).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to move the subcategories up: %w", err)
+		}
+
+		_, err = tx.NewDelete().Model((*models.Category)(nil)).Where("id = ?", id).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete a category: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return 0, err
+	}
+
+	return affected, nil
+}
+
+// reassignBooks moves the books of the category to the target category, it becomes their primary category
+// when the moved category was
+func reassignBooks(ctx context.Context, tx bun.Tx, categoryID, targetID int) error {
+	exists, err := tx.NewSelect().Model((*models.Category)(nil)).Where("id = ?", targetID).Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the target category: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %d", domain.ErrReassignTargetNotFound, targetID)
+	}
+
+	_, err = tx.NewRaw(`INSERT INTO ?0 (book_id, category_id)
+		SELECT book_id, ?2 FROM ?0 WHERE category_id = ?1
+		ON CONFLICT DO NOTHING`,
+		bun.Ident("book_categories"), categoryID, targetID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to add the books to the target category: %w", err)
+	}
+
+	_, err = tx.NewDelete().Model((*models.BookCategory)(nil)).Where("category_id = ?", categoryID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove the books from the category: %w", err)
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*models.Book)(nil)).
+		Set("category_id = ?", targetID).
+		Set("updated_at = now()").
+		Where("category_id = ?", categoryID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reassign the primary category: %w", err)
+	}
+
+	return nil
+}
+
+// archiveBooks removes the books from the category and archives the ones left without a category. The books that
+// still belong to other categories are kept, those the deleted category was the primary category of switch to another one
+func archiveBooks(ctx context.Context, tx bun.Tx, categoryID int, bookIDs []int) error {
+	_, err := tx.NewDelete().Model((*models.BookCategory)(nil)).Where("category_id = ?", categoryID).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove the books from the category: %w", err)
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*models.Book)(nil)).
+		Set("category_id = (SELECT min(bc.category_id) FROM book_categories AS bc WHERE bc.book_id = ?TableAlias.id)").
+		Set("updated_at = now()").
+		Where("category_id = ?", categoryID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to replace the primary category: %w", err)
+	}
+
+	var archivedIDs []int
+	err = tx.NewUpdate().
+		Model((*models.Book)(nil)).
+		Set("archived_at = coalesce(archived_at, now())").
+		Set("updated_at = now()").
+		Where("id IN (?)", bun.In(bookIDs)).
+		Where("NOT EXISTS (SELECT 1 FROM book_categories AS bc WHERE bc.book_id = ?TableAlias.id)").
+		Returning("id").
+		Scan(ctx, &archivedIDs)
+	if err != nil {
+		return fmt.Errorf("failed to archive books: %w", err)
+	}
+
+	return releaseArchivedBooks(ctx, tx, archivedIDs)
+}
+
+// lockCategoryCarts locks the user and guest carts holding books of the category. The carts are locked before
+// the books, like the cart changes and the checkout do, so that none of them works on a stale copy of the cart
+// while its archived books are released
+func lockCategoryCarts(ctx context.Context, tx bun.Tx, categoryID int) error {
+	books := tx.NewSelect().
+		Model((*models.Book)(nil)).
+		Column("id").
+		Where("category_id = ? OR id IN (?)", categoryID,
+			tx.NewSelect().Model((*models.BookCategory)(nil)).Column("book_id").Where("category_id = ?", categoryID))
+
+	var userIDs []int
+	err := tx.NewSelect().Model((*models.Cart)(nil)).
+		Column("user_id").
+		Where("user_id IN (?)", tx.NewSelect().Model((*models.CartItem)(nil)).Column("user_id").Where("book_id IN (?)", books)).
+		Order("user_id").
+		For("UPDATE").
+		Scan(ctx, &userIDs)
+	if err != nil {
+		return fmt.Errorf("failed to lock the carts of the category: %w", err)
+	}
+
+	var tokens []string
+	err = tx.NewSelect().Model((*models.GuestCart)(nil)).
+		Column("token").
+		Where("token IN (?)", tx.NewSelect().Model((*models.GuestCartItem)(nil)).Column("token").Where("book_id IN (?)", books)).
+		Order("token").
+		For("UPDATE").
+		Scan(ctx, &tokens)
+	if err != nil {
+		return fmt.Errorf("failed to lock the guest carts of the category: %w", err)
+	}
+
+	return nil
+}
+
+// releaseArchivedBooks removes the archived books from the user and guest carts and releases their reservations,
+// the carts left empty are deleted
+func releaseArchivedBooks(ctx context.Context, tx bun.Tx, bookIDs []int) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	var items []models.CartItem
+	err := tx.NewSelect().Model(&items).Where("book_id IN (?)", bun.In(bookIDs)).Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the cart items of archived books: %w", err)
+	}
+	var guestItems []models.GuestCartItem
+	err = tx.NewSelect().Model(&guestItems).Where("book_id IN (?)", bun.In(bookIDs)).Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the guest cart items of archived books: %w", err)
+	}
+
+	userIDs := make([]int, 0, len(items))
+	quantities := make(map[int]int, len(bookIDs))
+	for _, item := range items {
+		userIDs = append(userIDs, item.UserID)
+		quantities[item.BookID] += item.Quantity
+	}
+	tokens := make([]string, 0, len(guestItems))
+	for _, item := range guestItems {
+		tokens = append(tokens, item.Token)
+		quantities[item.BookID] += item.Quantity
+	}
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	// the archived books are already locked by the category deletion
+	err = changeStocks(ctx, tx, quantities, stockMovement{kind: domain.MovementRelease, reason: "book archived"})
 	if err != nil {
-		return fmt.Errorf("failed to delete a category: %w", err)
+		return err
+	}
+
+	if len(userIDs) > 0 {
+		_, err = tx.NewDelete().Model((*models.CartItem)(nil)).Where("book_id IN (?)", bun.In(bookIDs)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete the cart items of archived books: %w", err)
+		}
+
+		_, err = tx.NewDelete().Model((*models.Cart)(nil)).
+			Where("user_id IN (?)", bun.In(userIDs)).
+			Where("NOT EXISTS (SELECT 1 FROM cart_items AS ci WHERE ci.user_id = ?TableAlias.user_id)").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete empty carts: %w", err)
+		}
+	}
+
+	if len(tokens) > 0 {
+		_, err = tx.NewDelete().Model((*models.GuestCartItem)(nil)).Where("book_id IN (?)", bun.In(bookIDs)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete the guest cart items of archived books: %w", err)
+		}
+
+		_, err = tx.NewDelete().Model((*models.GuestCart)(nil)).
+			Where("token IN (?)", bun.In(tokens)).
+			Where("NOT EXISTS (SELECT 1 FROM guest_cart_items AS gci WHERE gci.token = ?TableAlias.token)").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete empty guest carts: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// List retrieves all categories
-func (r *CategoryRepository) GetCategories(ctx context.Context) ([]domain.Category, error) {
+// GetCategoryTree retrieves all the categories nested under their parents
+func (r *CategoryRepository) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
 	var categories []models.Category
-	err := r.db.NewSelect().Model(&categories).Order("id").Scan(ctx)
+	err := selectCategories(r.db.NewSelect().Model(&categories)).Order("display_order", "id").Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to select categories: %w", err)
 	}
 
-	var domainCategories []domain.Category
+	domainCategories := make([]domain.Category, 0, len(categories))
 	for _, category := range categories {
 		domainCategory, err := categoryToDomain(category)
 		if err != nil {
@@ -110,5 +427,142 @@ func (r *CategoryRepository) GetCategories(ctx context.Context) ([]domain.Catego
 		domainCategories = append(domainCategories, domainCategory)
 	}
 
-	return domainCategories, nil
+	return domain.NewCategoryTree(domainCategories)
+}
+
+// selectCategories selects the categories with the number of their books in stock
+func selectCategories(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.ColumnExpr("?TableAlias.*").
+		ColumnExpr(`(SELECT count(*) FROM book_categories AS bc JOIN books AS b ON b.id = bc.book_id
+			WHERE bc.category_id = ?TableAlias.id AND b.stock > 0 AND b.archived_at IS NULL) AS book_count`)
+}
+
+// uniqueCategorySlug returns the slug of the category unless another category has it. A taken slug that was
+// generated from the name gets the first free numeric suffix, a taken slug chosen by an admin fails.
+func uniqueCategorySlug(ctx context.Context, tx bun.Tx, category domain.Category) (string, error) {
+	var taken []string
+	err := tx.NewSelect().
+		Model((*models.Category)(nil)).
+		Column("slug").
+		Where("id <> ?", category.ID()).
+		Where("slug = ? OR slug LIKE ?", category.Slug(), escapeLike(category.Slug())+"-%").
+		Scan(ctx, &taken)
+	if err != nil {
+		return "", fmt.Errorf("failed to select taken slugs: %w", err)
+	}
+	if !slices.Contains(taken, category.Slug()) {
+		return category.Slug(), nil
+	}
+	if !category.SlugGenerated() {
+		return "", fmt.Errorf("%w: %s", domain.ErrCategorySlugTaken, category.Slug())
+	}
+
+	for n := 2; ; n++ {
+		suffix := "-" + strconv.Itoa(n)
+		base := category.Slug()
+		if len(base)+len(suffix) > domain.MaxCategorySlugLength {
+			base = strings.TrimRight(base[:domain.MaxCategorySlugLength-len(suffix)], "-")
+		}
+		if slug := base + suffix; !slices.Contains(taken, slug) {
+			return slug, nil
+		}
+	}
+}
+
+// lockCategories serializes the changes of the category tree while letting it be read,
+// so that concurrent moves can't close a cycle the checks of each other missed
+func lockCategories(ctx context.Context, tx bun.Tx) error {
+	_, err := tx.ExecContext(ctx, "LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE")
+	if err != nil {
+		return fmt.Errorf("failed to lock categories: %w", err)
+	}
+
+	return nil
+}
+
+// checkCategoryParent makes sure the parent exists and is neither the category nor one of its descendants
+func checkCategoryParent(ctx context.Context, tx bun.Tx, categoryID, parentID int) error {
+	if parentID == 0 {
+		return nil
+	}
+
+	var ancestorIDs []int
+	err := tx.NewRaw(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM ?0 WHERE id = ?1
+			UNION
+			SELECT c.id, c.parent_id FROM ?0 AS c JOIN ancestors AS a ON c.id = a.parent_id
+		)
+		SELECT id FROM ancestors`,
+		bun.Ident("categories"), parentID).
+		Scan(ctx, &ancestorIDs)
+	if err != nil {
+		return fmt.Errorf("failed to select the ancestors of the parent category: %w", err)
+	}
+	if len(ancestorIDs) == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrParentCategoryNotFound, parentID)
+	}
+	if slices.Contains(ancestorIDs, categoryID) {
+		return fmt.Errorf("%w: %d is a subcategory of %d", domain.ErrCategoryCycle, parentID, categoryID)
+	}
+
+	return nil
+}
+
+// GetCategories retrieves a page of the categories ordered by their display order and then by ID
+func (r *CategoryRepository) GetCategories(ctx context.Context, page domain.PageRequest) (domain.Page[domain.Category], error) {
+	var categories []models.Category
+	query := selectCategories(r.db.NewSelect().Model(&categories))
+	if cursor, ok := page.Cursor(); ok {
+		if cursor.Sort() != categorySort {
+			return domain.Page[domain.Category]{}, fmt.Errorf("%w: it belongs to the %s sort", domain.ErrInvalidCursor, cursor.Sort())
+		}
+		displayOrder, err := strconv.Atoi(cursor.Key())
+		if err != nil {
+			return domain.Page[domain.Category]{}, fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+		}
+		query.Where("(display_order, id) > (?, ?)", displayOrder, cursor.ID())
+	} else if page.Offset() > 0 {
+		query.Offset(page.Offset())
+	}
+	// the extra category tells whether there is a next page
+	err := query.Order("display_order", "id").Limit(page.Size() + 1).Scan(ctx)
+	if err != nil {
+		return domain.Page[domain.Category]{}, fmt.Errorf("failed to select categories: %w", err)
+	}
+
+	var nextCursor string
+	if len(categories) > page.Size() {
+		categories = categories[:page.Size()]
+		last := categories[len(categories)-1]
+		cursor, err := domain.NewCursor(categorySort, strconv.Itoa(last.DisplayOrder), last.ID)
+		if err != nil {
+			return domain.Page[domain.Category]{}, fmt.Errorf("failed to create cursor: %w", err)
+		}
+		nextCursor = cursor.Encode()
+	}
+
+	var total int
+	if page.WithTotal() {
+		total, err = r.db.NewSelect().Model((*models.Category)(nil)).Count(ctx)
+		if err != nil {
+			return domain.Page[domain.Category]{}, fmt.Errorf("failed to count categories: %w", err)
+		}
+	}
+
+	domainCategories := make([]domain.Category, 0, len(categories))
+	for _, category := range categories {
+		domainCategory, err := categoryToDomain(category)
+		if err != nil {
+			return domain.Page[domain.Category]{}, fmt.Errorf("failed to create domain category: %w", err)
+		}
+
+		domainCategories = append(domainCategories, domainCategory)
+	}
+
+	return domain.NewPage(domain.NewPageData[domain.Category]{
+		Items:      domainCategories,
+		NextCursor: nextCursor,
+		Total:      total,
+		Counted:    page.WithTotal(),
+	})
 }
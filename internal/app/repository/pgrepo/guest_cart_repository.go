@@ -0,0 +1,312 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+)
+
+// GetGuestCartItems returns the books in the guest cart ordered by ID
+func (r CartRepository) GetGuestCartItems(ctx context.Context, token string) ([]domain.CartItem, error) {
+	var cartItems []models.GuestCartItem
+	err := r.db.NewSelect().Model(&cartItems).
+		Relation("Book").
+		Where("guest_cart_item.token = ?", token).
+		Order("guest_cart_item.book_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest cart items: %w", err)
+	}
+
+	items := make([]domain.CartItem, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		item, err := guestCartItemToDomain(cartItem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain cart item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// UpdateGuestCart works like UpdateCart for the guest cart with the token, the cart is created when it doesn't exist
+func (r CartRepository) UpdateGuestCart(ctx context.Context, token string, updateFn func(cart *domain.Cart) error) error {
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		now := time.Now()
+
+		// creating the cart row locks it for the rest of the transaction
+		dbCart := models.GuestCart{Token: token, UpdatedAt: now}
+		_, err := tx.NewInsert().Model(&dbCart).
+			On("CONFLICT (token) DO UPDATE").
+			Set("updated_at = EXCLUDED.updated_at").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to lock guest cart: %w", err)
+		}
+
+		oldCart, err := loadGuestCart(ctx, tx, token)
+		if err != nil {
+			return err
+		}
+
+		cart := oldCart.Clone()
+		err = updateFn(&cart)
+		if err != nil {
+			return err
+		}
+
+		changes, err := reserveStocks(ctx, tx, oldCart, cart)
+		if err != nil {
+			return err
+		}
+
+		if len(changes.grown) > 0 {
+			items := make([]models.GuestCartItem, 0, len(changes.grown))
+			for _, bookID := range changes.grown {
+				items = append(items, models.GuestCartItem{
+					Token:      token,
+					BookID:     bookID,
+					Quantity:   cart.Quantity(bookID),
+					ReservedAt: now,
+					ExpiresAt:  now.Add(domain.CartReservationTTL),
+				})
+			}
+			_, err = tx.NewInsert().Model(&items).
+				On("CONFLICT (token, book_id) DO UPDATE").
+				Set("quantity = EXCLUDED.quantity").
+				Set("reserved_at = EXCLUDED.reserved_at").
+				Set("expires_at = EXCLUDED.expires_at").
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add guest cart items: %w", err)
+			}
+		}
+		for _, bookID := range changes.shrunk {
+			_, err := tx.NewUpdate().Model((*models.GuestCartItem)(nil)).
+				Set("quantity = ?", cart.Quantity(bookID)).
+				Where("token = ?", token).
+				Where("book_id = ?", bookID).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to update guest cart item: %w", err)
+			}
+		}
+		if len(changes.removed) > 0 {
+			_, err = tx.NewDelete().Model((*models.GuestCartItem)(nil)).
+				Where("token = ?", token).
+				Where("book_id in (?)", bun.In(changes.removed)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete guest cart items: %w", err)
+			}
+		}
+
+		if !cart.HasBooks() {
+			_, err := tx.NewDelete().Model((*models.GuestCart)(nil)).Where("token = ?", token).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete guest cart: %w", err)
+			}
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to update guest cart: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseGuestCart deletes a guest cart and puts its books back in stock
+func (r CartRepository) ReleaseGuestCart(ctx context.Context, token string) error {
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		_, err := releaseGuestCart(ctx, tx, token)
+		return err
+	}, r.db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to release guest cart: %w", err)
+	}
+
+	return nil
+}
+
+// MergeGuestCart moves the books of the guest cart into the user's cart with domain.Cart.Merge
+// in one transaction and deletes the guest cart. The guest reservations are released first and the merged
+// copies are reserved again for the user, the books that don't have enough stock left for them
+// keep as many guest copies as the stock allows. The books none of whose guest copies could be kept
+// are returned. An unknown token merges nothing.
+func (r CartRepository) MergeGuestCart(ctx context.Context, token string, userID int) ([]int, error) {
+	var dropped []int
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		guest, err := releaseGuestCart(ctx, tx, token)
+		if err != nil {
+			return err
+		}
+		if !guest.HasBooks() {
+			return nil
+		}
+
+		return updateUserCart(ctx, tx, userID, func(cart *domain.Cart) error {
+			merged := cart.Merge(guest)
+			added := merged.Diff(*cart)
+			if !added.HasBooks() {
+				*cart = merged
+				return nil
+			}
+
+			// the same check as CheckStocks, but under the stock locks of this transaction
+			stocks, err := lockReservableStocks(ctx, tx, added.BookIDs())
+			if err != nil {
+				return err
+			}
+			for _, bookID := range unavailableBooks(stocks, added) {
+				kept := max(stocks[bookID], 0)
+				err := merged.SetQuantity(bookID, cart.Quantity(bookID)+kept)
+				if err != nil {
+					return err
+				}
+				if kept == 0 {
+					dropped = append(dropped, bookID)
+				}
+			}
+
+			*cart = merged
+			return nil
+		})
+	}, r.db.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge guest cart: %w", err)
+	}
+
+	return dropped, nil
+}
+
+// releaseGuestCart locks and deletes the guest cart, puts its books back in stock and returns what was in it
+func releaseGuestCart(ctx context.Context, tx bun.Tx, token string) (domain.Cart, error) {
+	var dbCart models.GuestCart
+	err := tx.NewSelect().Model(&dbCart).Where("token = ?", token).For("UPDATE").Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.NewGuestCart(token)
+		}
+		return domain.Cart{}, fmt.Errorf("failed to lock guest cart: %w", err)
+	}
+
+	cart, err := loadGuestCart(ctx, tx, token)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+
+	if cart.HasBooks() {
+		_, err := lockStocks(ctx, tx, cart.BookIDs())
+		if err != nil {
+			return domain.Cart{}, err
+		}
+
+		err = changeStocks(ctx, tx, cartQuantities(cart), stockMovement{kind: domain.MovementRelease, reason: "guest cart released"})
+		if err != nil {
+			return domain.Cart{}, err
+		}
+	}
+
+	_, err = tx.NewDelete().Model((*models.GuestCart)(nil)).Where("token = ?", token).Exec(ctx)
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to delete guest cart: %w", err)
+	}
+
+	return cart, nil
+}
+
+// cleanExpiredGuestCarts does the work of CleanExpiredCarts for the guest carts
+func cleanExpiredGuestCarts(ctx context.Context, tx bun.Tx, now time.Time) error {
+	var carts []models.GuestCart
+	// carts that are being changed or merged right now are locked and skipped
+	err := tx.NewSelect().Model(&carts).
+		Where("token IN (?)", tx.NewSelect().Model((*models.GuestCartItem)(nil)).Column("token").Where("expires_at < ?", now)).
+		For("UPDATE SKIP LOCKED").
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get expired guest carts: %w", err)
+	}
+
+	for _, cart := range carts {
+		var items []models.GuestCartItem
+		err := tx.NewSelect().Model(&items).
+			Where("token = ?", cart.Token).
+			Where("expires_at < ?", now).
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get expired guest cart items: %w", err)
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		bookIDs := make([]int, 0, len(items))
+		quantities := make(map[int]int, len(items))
+		for _, item := range items {
+			bookIDs = append(bookIDs, item.BookID)
+			quantities[item.BookID] = item.Quantity
+		}
+
+		_, err = lockStocks(ctx, tx, bookIDs)
+		if err != nil {
+			return err
+		}
+		err = changeStocks(ctx, tx, quantities, stockMovement{kind: domain.MovementRelease, reason: "reservation expired"})
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewDelete().Model((*models.GuestCartItem)(nil)).
+			Where("token = ?", cart.Token).
+			Where("book_id in (?)", bun.In(bookIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired guest cart items: %w", err)
+		}
+
+		_, err = tx.NewDelete().Model((*models.GuestCart)(nil)).
+			Where("token = ?", cart.Token).
+			Where("NOT EXISTS (?)", tx.NewSelect().Model((*models.GuestCartItem)(nil)).ColumnExpr("1").Where("token = ?", cart.Token)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete empty guest cart: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// loadGuestCart returns the guest cart with the books in the order they were added, the cart is empty when it doesn't exist
+func loadGuestCart(ctx context.Context, db bun.IDB, token string) (domain.Cart, error) {
+	var items []models.GuestCartItem
+	err := db.NewSelect().Model(&items).
+		Where("token = ?", token).
+		Order("reserved_at", "book_id").
+		Scan(ctx)
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to get guest cart books: %w", err)
+	}
+
+	cart, err := domain.NewGuestCart(token)
+	if err != nil {
+		return domain.Cart{}, fmt.Errorf("failed to create domain cart: %w", err)
+	}
+	for _, item := range items {
+		err := cart.SetQuantity(item.BookID, item.Quantity)
+		if err != nil {
+			return domain.Cart{}, fmt.Errorf("failed to create domain cart: %w", err)
+		}
+	}
+
+	return cart, nil
+}
@@ -0,0 +1,125 @@
+package pgrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyRepository struct {
+	db *pg.DB
+}
+
+// NewIdempotencyRepository creates a new idempotency key repository instance
+func NewIdempotencyRepository(db *pg.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// AcquireKey saves the key unless the user already used it within ttl, or its request is still running within lease.
+// It returns the saved key and true, or the key that was already stored and false.
+func (r IdempotencyRepository) AcquireKey(ctx context.Context, key domain.IdempotencyKey, ttl, lease time.Duration) (domain.IdempotencyKey, bool, error) {
+	var storedKey domain.IdempotencyKey
+	var created bool
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		// an expired key can be used again
+		_, err := tx.NewDelete().Model((*models.IdempotencyKey)(nil)).
+			Where("user_id = ?", key.UserID()).
+			Where("key = ?", key.Key()).
+			Where("created_at < ?", time.Now().Add(-ttl)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired idempotency key: %w", err)
+		}
+
+		// the request of a key that didn't complete within the lease crashed, the retry takes the key over
+		_, err = tx.NewDelete().Model((*models.IdempotencyKey)(nil)).
+			Where("user_id = ?", key.UserID()).
+			Where("key = ?", key.Key()).
+			Where("completed_at IS NULL").
+			Where("created_at < ?", time.Now().Add(-lease)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete stale idempotency key: %w", err)
+		}
+
+		dbKey := domainToIdempotencyKey(key)
+		res, err := tx.NewInsert().Model(&dbKey).On("CONFLICT (user_id, key) DO NOTHING").Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert idempotency key: %w", err)
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to insert idempotency key: %w", err)
+		}
+		if inserted == 1 {
+			storedKey = key
+			created = true
+			return nil
+		}
+
+		var existing models.IdempotencyKey
+		err = tx.NewSelect().Model(&existing).
+			Where("user_id = ?", key.UserID()).
+			Where("key = ?", key.Key()).
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get idempotency key: %w", err)
+		}
+
+		storedKey, err = idempotencyKeyToDomain(existing)
+		if err != nil {
+			return fmt.Errorf("failed to create domain idempotency key: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return domain.IdempotencyKey{}, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+
+	return storedKey, created, nil
+}
+
+// CompleteKey saves the response of the request the key was used for
+func (r IdempotencyRepository) CompleteKey(ctx context.Context, key domain.IdempotencyKey) error {
+	dbKey := domainToIdempotencyKey(key)
+	_, err := r.db.NewUpdate().Model(&dbKey).
+		Column("status_code", "response", "completed_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteKey deletes an idempotency key
+func (r IdempotencyRepository) DeleteKey(ctx context.Context, userID int, key string) error {
+	_, err := r.db.NewDelete().Model((*models.IdempotencyKey)(nil)).
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredKeys deletes the keys that were first used more than ttl ago
+func (r IdempotencyRepository) DeleteExpiredKeys(ctx context.Context, ttl time.Duration) error {
+	_, err := r.db.NewDelete().Model((*models.IdempotencyKey)(nil)).
+		Where("created_at < ?", time.Now().Add(-ttl)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,385 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+)
+
+// inventoryHistoryLimit is the number of latest movements returned with an inventory
+const inventoryHistoryLimit = 100
+
+type InventoryRepository struct {
+	db *pg.DB
+}
+
+// NewInventoryRepository creates a new inventory ledger repository instance
+func NewInventoryRepository(db *pg.DB) *InventoryRepository {
+	return &InventoryRepository{db: db}
+}
+
+// RecordMovement applies the movement to the stock of its book, appends it to the ledger
+// and returns the book with the new stock
+func (r *InventoryRepository) RecordMovement(ctx context.Context, movement domain.InventoryMovement) (domain.Book, error) {
+	var book domain.Book
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		stocks, err := lockStocks(ctx, tx, []int{movement.BookID()})
+		if err != nil {
+			return err
+		}
+		stock, ok := stocks[movement.BookID()]
+		if !ok {
+			return domain.ErrNotFound
+		}
+		if stock+movement.Quantity() < 0 {
+			return fmt.Errorf("%w: only %d copies are in stock", domain.ErrInvalidQuantity, stock)
+		}
+
+		err = applyMovements(ctx, tx, []domain.InventoryMovement{movement})
+		if err != nil {
+			return err
+		}
+
+		var dbBook models.Book
+		err = selectBooks(tx.NewSelect().Model(&dbBook)).Where("id = ?", movement.BookID()).Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get a book: %w", err)
+		}
+		book, err = bookToDomain(dbBook)
+		if err != nil {
+			return fmt.Errorf("failed to create domain book: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return domain.Book{}, fmt.Errorf("failed to record inventory movement: %w", err)
+	}
+
+	return book, nil
+}
+
+// GetInventory retrieves the stock of the book, the stock derived from its ledger and its latest movements
+func (r *InventoryRepository) GetInventory(ctx context.Context, bookID int) (domain.Inventory, error) {
+	var inventory domain.Inventory
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		// stock changes lock the book, so the shared lock keeps the stock and the ledger in step while they are read
+		var book models.Book
+		err := tx.NewSelect().Model(&book).Column("id", "stock").Where("id = ?", bookID).For("SHARE").Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("failed to get a book: %w", err)
+		}
+
+		var ledgerStock int
+		err = tx.NewSelect().Model((*models.InventoryMovement)(nil)).
+			ColumnExpr("COALESCE(SUM(quantity), 0)").
+			Where("book_id = ?", bookID).
+			Scan(ctx, &ledgerStock)
+		if err != nil {
+			return fmt.Errorf("failed to sum inventory movements: %w", err)
+		}
+
+		var movements []models.InventoryMovement
+		err = tx.NewSelect().Model(&movements).
+			Where("book_id = ?", bookID).
+			Order("id DESC").
+			Limit(inventoryHistoryLimit).
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get inventory movements: %w", err)
+		}
+
+		domainMovements := make([]domain.InventoryMovement, 0, len(movements))
+		for _, movement := range movements {
+			domainMovement, err := inventoryMovementToDomain(movement)
+			if err != nil {
+				return fmt.Errorf("failed to create domain inventory movement: %w", err)
+			}
+			domainMovements = append(domainMovements, domainMovement)
+		}
+
+		inventory, err = domain.NewInventory(domain.NewInventoryData{
+			BookID:      book.ID,
+			Stock:       book.Stock,
+			LedgerStock: ledgerStock,
+			Movements:   domainMovements,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create domain inventory: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return domain.Inventory{}, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+// stockCounts are the copies of a book counted by a reconciliation
+type stockCounts struct {
+	ID        int    `bun:"id"`
+	Title     string `bun:"title"`
+	Stock     int    `bun:"stock"`
+	Restocked int    `bun:"restocked"`
+	Reserved  int    `bun:"reserved"`
+	Sold      int    `bun:"sold"`
+}
+
+// ReconcileStocks compares the stock of every book with the stock expected from its restocks, active cart reservations
+// and sales. With fix the stocks that don't match are corrected by adjustments unless the expected stock is negative.
+func (r *InventoryRepository) ReconcileStocks(ctx context.Context, fix bool, actorID int) ([]domain.StockReconciliation, error) {
+	var reconciliations []domain.StockReconciliation
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		counts, err := countStocks(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+		reconciliations, err = stockReconciliations(counts, nil)
+		if err != nil {
+			return err
+		}
+		if !fix {
+			return nil
+		}
+
+		var mismatched []int
+		for _, reconciliation := range reconciliations {
+			if reconciliation.Fixable() {
+				mismatched = append(mismatched, reconciliation.BookID())
+			}
+		}
+		if len(mismatched) == 0 {
+			return nil
+		}
+
+		fixed, err := fixStocks(ctx, tx, mismatched, actorID)
+		if err != nil {
+			return err
+		}
+
+		// the stocks could change before they were locked, so the books are counted again
+		counts, err = countStocks(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+		reconciliations, err = stockReconciliations(counts, fixed)
+
+		return err
+	}, r.db.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile stocks: %w", err)
+	}
+
+	return reconciliations, nil
+}
+
+// fixStocks locks the stocks of the books, counts them again and corrects the ones that still don't match
+// by adjustments, it returns the books that were fixed
+func fixStocks(ctx context.Context, tx bun.Tx, bookIDs []int, actorID int) (map[int]bool, error) {
+	_, err := lockStocks(ctx, tx, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := countStocks(ctx, tx, bookIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliations, err := stockReconciliations(counts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	fixed := make(map[int]bool)
+	movements := make([]domain.InventoryMovement, 0, len(reconciliations))
+	for _, reconciliation := range reconciliations {
+		if !reconciliation.Fixable() {
+			continue
+		}
+
+		movement, err := domain.NewInventoryMovement(domain.NewInventoryMovementData{
+			BookID:    reconciliation.BookID(),
+			Kind:      domain.MovementAdjustment,
+			Quantity:  -reconciliation.Difference(),
+			Reason:    "stock reconciliation",
+			ActorID:   actorID,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain inventory movement: %w", err)
+		}
+		movements = append(movements, movement)
+		fixed[reconciliation.BookID()] = true
+	}
+
+	err = applyMovements(ctx, tx, movements)
+	if err != nil {
+		return nil, err
+	}
+
+	return fixed, nil
+}
+
+// countStocks counts the restocked, reserved and sold copies of the books ordered by ID, all books when bookIDs is nil.
+// Adjustments aren't counted as they are the corrections of the stocks.
+func countStocks(ctx context.Context, tx bun.Tx, bookIDs []int) ([]stockCounts, error) {
+	query := tx.NewSelect().
+		TableExpr("? AS b", bun.Ident("books")).
+		ColumnExpr("b.id, b.title, b.stock").
+		ColumnExpr("COALESCE((SELECT SUM(m.quantity) FROM ? AS m WHERE m.book_id = b.id AND m.kind = ?), 0) AS restocked",
+			bun.Ident("inventory_movements"), domain.MovementRestock).
+		ColumnExpr("COALESCE((SELECT SUM(ci.quantity) FROM ? AS ci WHERE ci.book_id = b.id), 0)"+
+			" + COALESCE((SELECT SUM(gi.quantity) FROM ? AS gi WHERE gi.book_id = b.id), 0) AS reserved",
+			bun.Ident("cart_items"), bun.Ident("guest_cart_items")).
+		// the copies of the orders refunded before they shipped were put back in stock like the cancelled ones
+		ColumnExpr("COALESCE((SELECT SUM(oi.quantity) FROM ? AS oi JOIN ? AS o ON o.id = oi.order_id"+
+			" WHERE oi.book_id = b.id AND o.status <> ?"+
+			" AND NOT EXISTS (SELECT 1 FROM ? AS h WHERE h.order_id = o.id AND h.from_status = ? AND h.to_status = ?)), 0) AS sold",
+			bun.Ident("order_items"), bun.Ident("orders"), domain.OrderStatusCancelled,
+			bun.Ident("order_status_history"), domain.OrderStatusPaid, domain.OrderStatusRefunded).
+		Order("b.id")
+	if bookIDs != nil {
+		query = query.Where("b.id IN (?)", bun.In(bookIDs))
+	}
+
+	var counts []stockCounts
+	err := query.Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stocks: %w", err)
+	}
+
+	return counts, nil
+}
+
+// stockReconciliations creates domain reconciliations from the counted copies of the books
+func stockReconciliations(counts []stockCounts, fixed map[int]bool) ([]domain.StockReconciliation, error) {
+	reconciliations := make([]domain.StockReconciliation, 0, len(counts))
+	for _, count := range counts {
+		reconciliation, err := domain.NewStockReconciliation(domain.NewStockReconciliationData{
+			BookID:    count.ID,
+			Title:     count.Title,
+			Stock:     count.Stock,
+			Restocked: count.Restocked,
+			Reserved:  count.Reserved,
+			Sold:      count.Sold,
+			Fixed:     fixed[count.ID],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain stock reconciliation: %w", err)
+		}
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	return reconciliations, nil
+}
+
+// stockMovement tells why the stocks of books change, every book gets its own entry of this kind in the ledger
+type stockMovement struct {
+	kind    domain.MovementKind
+	reason  string
+	actorID int
+}
+
+// changeStocks moves the quantity of copies of every book in or out of stock depending on the kind of the movement
+// and records it in the inventory ledger, the stocks have to be locked by lockStocks
+func changeStocks(ctx context.Context, tx bun.Tx, quantities map[int]int, movement stockMovement) error {
+	movements, err := stockMovements(quantities, movement)
+	if err != nil {
+		return err
+	}
+
+	return applyMovements(ctx, tx, movements)
+}
+
+// stockMovements builds the ledger entries of the movement ordered by book ID
+func stockMovements(quantities map[int]int, movement stockMovement) ([]domain.InventoryMovement, error) {
+	sign := 1
+	if movement.kind.Outgoing() {
+		sign = -1
+	}
+
+	now := time.Now()
+	movements := make([]domain.InventoryMovement, 0, len(quantities))
+	for _, bookID := range slices.Sorted(maps.Keys(quantities)) {
+		domainMovement, err := domain.NewInventoryMovement(domain.NewInventoryMovementData{
+			BookID:    bookID,
+			Kind:      movement.kind,
+			Quantity:  sign * quantities[bookID],
+			Reason:    movement.reason,
+			ActorID:   movement.actorID,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain inventory movement: %w", err)
+		}
+		movements = append(movements, domainMovement)
+	}
+
+	return movements, nil
+}
+
+// applyMovements changes the stocks by the movements and appends them to the ledger, the stocks have to be locked by lockStocks.
+// The subscribers of the books that were sold out and are back in stock get notified.
+func applyMovements(ctx context.Context, tx bun.Tx, movements []domain.InventoryMovement) error {
+	var backInStock []int
+	applied := make([]domain.InventoryMovement, 0, len(movements))
+	for _, movement := range movements {
+		var stock int
+		err := tx.NewUpdate().Model((*models.Book)(nil)).
+			Set("stock = stock + ?", movement.Quantity()).
+			Where("id = ?", movement.BookID()).
+			Returning("stock").
+			Scan(ctx, &stock)
+		if err != nil {
+			// the book was deleted
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return fmt.Errorf("failed to change stock: %w", err)
+		}
+		applied = append(applied, movement)
+		if stock > 0 && stock-movement.Quantity() <= 0 {
+			backInStock = append(backInStock, movement.BookID())
+		}
+	}
+
+	err := recordMovements(ctx, tx, applied)
+	if err != nil {
+		return err
+	}
+
+	return queueBackInStock(ctx, tx, backInStock)
+}
+
+// recordMovements appends the movements to the ledger without touching the stocks
+func recordMovements(ctx context.Context, tx bun.Tx, movements []domain.InventoryMovement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	dbMovements := make([]models.InventoryMovement, 0, len(movements))
+	for _, movement := range movements {
+		dbMovements = append(dbMovements, domainToInventoryMovement(movement))
+	}
+	_, err := tx.NewInsert().Model(&dbMovements).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to insert inventory movements: %w", err)
+	}
+
+	return nil
+}
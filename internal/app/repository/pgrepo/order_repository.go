@@ -0,0 +1,350 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+)
+
+// orderSort is the only order of the orders listing, newest first and then by ID
+const orderSort = "newest"
+
+type OrderRepository struct {
+	db *pg.DB
+}
+
+// NewOrderRepository creates a new order repository instance
+func NewOrderRepository(db *pg.DB) *OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+// CreateOrderFromCart turns the user's cart into a pending order and deletes the cart with its items in one
+// transaction. The stock was already reduced when the books were put in the cart, the pending order keeps those
+// reservations until it's paid, when the sale is recorded, or cancelled, when the books are put back in stock.
+// The order is paid outside of the transaction, so no rows stay locked while the payment provider is called.
+func (r *OrderRepository) CreateOrderFromCart(ctx context.Context, userID int) (domain.Order, error) {
+	var order domain.Order
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		var cart models.Cart
+		err := tx.NewSelect().Model(&cart).Where("user_id = ?", userID).For("UPDATE").Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return slugerrors.NewBadRequestError("cart is empty", "empty-cart")
+			}
+			return fmt.Errorf("failed to lock cart: %w", err)
+		}
+
+		domainCart, err := loadCart(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if !domainCart.HasBooks() {
+			return slugerrors.NewBadRequestError("cart is empty", "empty-cart")
+		}
+
+		// the books are share locked so that they can't be archived while they are ordered
+		var books []models.Book
+		err = tx.NewSelect().Model(&books).Where("id IN (?)", bun.In(domainCart.BookIDs())).Order("id").For("SHARE").Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get cart books: %w", err)
+		}
+
+		// a book archived since it was put in the cart can't be ordered anymore
+		if len(books) < len(domainCart.BookIDs()) || slices.ContainsFunc(books, func(book models.Book) bool { return !book.ArchivedAt.IsZero() }) {
+			return slugerrors.NewBadRequestError("some books in the cart are no longer sold", "book-archived")
+		}
+
+		items := make([]domain.NewOrderItemData, 0, len(books))
+		for _, book := range books {
+			items = append(items, domain.NewOrderItemData{
+				BookID:   book.ID,
+				Title:    book.Title,
+				Price:    book.Price,
+				Quantity: domainCart.Quantity(book.ID),
+			})
+		}
+
+		newOrder, err := domain.NewOrder(domain.NewOrderData{
+			UserID: userID,
+			Items:  items,
+			Status: domain.OrderStatusPending,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create domain order: %w", err)
+		}
+
+		dbOrder := domainToOrder(newOrder)
+		err = tx.NewInsert().Model(&dbOrder).Returning("*").Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert an order: %w", err)
+		}
+
+		for i := range dbOrder.Items {
+			dbOrder.Items[i].OrderID = dbOrder.ID
+		}
+		_, err = tx.NewInsert().Model(&dbOrder.Items).Returning("*").Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert order items: %w", err)
+		}
+
+		order, err = orderToDomain(dbOrder)
+		if err != nil {
+			return fmt.Errorf("failed to create domain order: %w", err)
+		}
+
+		_, err = tx.NewDelete().Model((*models.Cart)(nil)).Where("user_id = ?", userID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete cart: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("failed to create order from cart: %w", err)
+	}
+
+	return order, nil
+}
+
+// GetOrder retrieves an order with its items by ID
+func (r *OrderRepository) GetOrder(ctx context.Context, id int) (domain.Order, error) {
+	var order models.Order
+	err := r.db.NewSelect().Model(&order).
+		Relation("Items", orderItemsByID).
+		Relation("History", orderHistoryByID).
+		Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Order{}, domain.ErrNotFound
+		}
+		return domain.Order{}, fmt.Errorf("failed to get an order: %w", err)
+	}
+
+	domainOrder, err := orderToDomain(order)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("failed to create domain order: %w", err)
+	}
+
+	return domainOrder, nil
+}
+
+// GetOrders retrieves a page of orders with their items and history newest first, userID 0 returns the orders of all users
+func (r *OrderRepository) GetOrders(ctx context.Context, userID int, page domain.PageRequest) (domain.Page[domain.Order], error) {
+	var orders []models.Order
+	query := r.db.NewSelect().Model(&orders).
+		Relation("Items", orderItemsByID).
+		Relation("History", orderHistoryByID)
+	if userID > 0 {
+		query.Where("user_id = ?", userID)
+	}
+	if cursor, ok := page.Cursor(); ok {
+		if cursor.Sort() != orderSort {
+			return domain.Page[domain.Order]{}, fmt.Errorf("%w: it belongs to the %s sort", domain.ErrInvalidCursor, cursor.Sort())
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Key())
+		if err != nil {
+			return domain.Page[domain.Order]{}, fmt.Errorf("%w: %w", domain.ErrInvalidCursor, err)
+		}
+		query.Where("(created_at, id) < (?, ?)", createdAt, cursor.ID())
+	} else if page.Offset() > 0 {
+		query.Offset(page.Offset())
+	}
+	// the extra order tells whether there is a next page
+	err := query.Order("created_at DESC", "id DESC").Limit(page.Size() + 1).Scan(ctx)
+	if err != nil {
+		return domain.Page[domain.Order]{}, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	var nextCursor string
+	if len(orders) > page.Size() {
+		orders = orders[:page.Size()]
+		last := orders[len(orders)-1]
+		cursor, err := domain.NewCursor(orderSort, last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+		if err != nil {
+			return domain.Page[domain.Order]{}, fmt.Errorf("failed to create cursor: %w", err)
+		}
+		nextCursor = cursor.Encode()
+	}
+
+	var total int
+	if page.WithTotal() {
+		count := r.db.NewSelect().Model((*models.Order)(nil))
+		if userID > 0 {
+			count.Where("user_id = ?", userID)
+		}
+		total, err = count.Count(ctx)
+		if err != nil {
+			return domain.Page[domain.Order]{}, fmt.Errorf("failed to count orders: %w", err)
+		}
+	}
+
+	domainOrders := make([]domain.Order, len(orders))
+	for i, order := range orders {
+		domainOrder, err := orderToDomain(order)
+		if err != nil {
+			return domain.Page[domain.Order]{}, fmt.Errorf("failed to create domain order: %w", err)
+		}
+
+		domainOrders[i] = domainOrder
+	}
+
+	return domain.NewPage(domain.NewPageData[domain.Order]{
+		Items:      domainOrders,
+		NextCursor: nextCursor,
+		Total:      total,
+		Counted:    page.WithTotal(),
+	})
+}
+
+// UpdateOrder locks the order, applies updateFn to it and saves the new status
+// together with the transitions updateFn has added to the order history.
+// When the order gets paid the sale is recorded and when it gets cancelled or refunded before it shipped
+// the books are put back in stock in the same transaction.
+func (r *OrderRepository) UpdateOrder(ctx context.Context, id int, updateFn func(order *domain.Order) error) (domain.Order, error) {
+	var order domain.Order
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		var dbOrder models.Order
+		err := tx.NewSelect().Model(&dbOrder).
+			Relation("Items", orderItemsByID).
+			Relation("History", orderHistoryByID).
+			Where("id = ?", id).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return fmt.Errorf("failed to lock an order: %w", err)
+		}
+
+		order, err = orderToDomain(dbOrder)
+		if err != nil {
+			return fmt.Errorf("failed to create domain order: %w", err)
+		}
+		persistedHistory := len(order.History())
+
+		err = updateFn(&order)
+		if err != nil {
+			return err
+		}
+
+		err = r.saveOrderChanges(ctx, tx, order, persistedHistory)
+		if err != nil {
+			return err
+		}
+
+		if order.Status() == domain.OrderStatusPaid && dbOrder.Status == string(domain.OrderStatusPending) {
+			err := recordSale(ctx, tx, order)
+			if err != nil {
+				return err
+			}
+		}
+		if domain.OrderStatus(dbOrder.Status).Restocks(order.Status()) {
+			err := r.restock(ctx, tx, order)
+			if err != nil {
+				return fmt.Errorf("failed to restock order books: %w", err)
+			}
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	return order, nil
+}
+
+// saveOrderChanges saves the status, the payment and the refund of the order together with
+// the transitions that were added after the first persistedHistory ones
+func (r *OrderRepository) saveOrderChanges(ctx context.Context, tx bun.Tx, order domain.Order, persistedHistory int) error {
+	dbOrder := domainToOrder(order)
+	_, err := tx.NewUpdate().Model(&dbOrder).
+		Column("status", "payment_id", "refunded_at", "updated_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update an order: %w", err)
+	}
+
+	for _, transition := range order.History()[persistedHistory:] {
+		dbTransition := domainToOrderTransition(order.ID(), transition)
+		_, err := tx.NewInsert().Model(&dbTransition).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert order transition: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recordSale records in the inventory ledger that the reserved copies of the paid order were sold.
+// The stock doesn't change, the reservations are released and the same copies are taken by the sale.
+func recordSale(ctx context.Context, tx bun.Tx, order domain.Order) error {
+	quantities := make(map[int]int, len(order.Items()))
+	for _, item := range order.Items() {
+		quantities[item.BookID()] += item.Quantity()
+	}
+
+	reason := fmt.Sprintf("order %d", order.ID())
+	released, err := stockMovements(quantities, stockMovement{kind: domain.MovementRelease, reason: reason, actorID: order.UserID()})
+	if err != nil {
+		return err
+	}
+	sold, err := stockMovements(quantities, stockMovement{kind: domain.MovementSale, reason: reason, actorID: order.UserID()})
+	if err != nil {
+		return err
+	}
+
+	return recordMovements(ctx, tx, append(released, sold...))
+}
+
+// restock puts the bought copies of the cancelled or refunded order back in stock
+func (r *OrderRepository) restock(ctx context.Context, tx bun.Tx, order domain.Order) error {
+	var bookIDs []int
+	quantities := make(map[int]int, len(order.Items()))
+	for _, item := range order.Items() {
+		// the book was deleted after the purchase
+		if item.BookID() == 0 {
+			continue
+		}
+		bookIDs = append(bookIDs, item.BookID())
+		quantities[item.BookID()] += item.Quantity()
+	}
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	_, err := lockStocks(ctx, tx, bookIDs)
+	if err != nil {
+		return err
+	}
+
+	movement := stockMovement{kind: domain.MovementRelease, reason: fmt.Sprintf("order %d %s", order.ID(), order.Status())}
+	if history := order.History(); len(history) > 0 {
+		movement.actorID = history[len(history)-1].ActorID()
+	}
+	err = changeStocks(ctx, tx, quantities, movement)
+	if err != nil {
+		return fmt.Errorf("failed to add stock: %w", err)
+	}
+
+	return nil
+}
+
+func orderItemsByID(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("id")
+}
+
+func orderHistoryByID(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("id")
+}
@@ -0,0 +1,213 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+)
+
+type SubscriptionRepository struct {
+	db *pg.DB
+}
+
+// NewSubscriptionRepository creates a new back-in-stock subscription repository instance
+func NewSubscriptionRepository(db *pg.DB) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db}
+}
+
+// Subscribe saves the subscription to a sold-out book, subscribing twice is a no-op.
+// It fails with the book-not-found or book-in-stock slug.
+func (r *SubscriptionRepository) Subscribe(ctx context.Context, subscription domain.BookSubscription) error {
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		// the shared lock makes a concurrent restock wait for the subscription, so it is not missed
+		var book models.Book
+		err := tx.NewSelect().Model(&book).
+			Column("id", "stock").
+			Where("id = ?", subscription.BookID()).
+			For("SHARE").
+			Scan(ctx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return slugerrors.NewNotFoundError("book not found", "book-not-found")
+			}
+			return fmt.Errorf("failed to get a book: %w", err)
+		}
+		if book.Stock > 0 {
+			return slugerrors.NewBadRequestError("book is in stock", "book-in-stock")
+		}
+
+		dbSubscription := models.BookSubscription{
+			UserID:    subscription.UserID(),
+			BookID:    subscription.BookID(),
+			CreatedAt: subscription.CreatedAt(),
+		}
+		_, err = tx.NewInsert().Model(&dbSubscription).On("CONFLICT (user_id, book_id) DO NOTHING").Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert a subscription: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	return nil
+}
+
+// Unsubscribe deletes the subscription of the user to the book
+func (r *SubscriptionRepository) Unsubscribe(ctx context.Context, userID, bookID int) error {
+	res, err := r.db.NewDelete().Model((*models.BookSubscription)(nil)).
+		Where("user_id = ?", userID).
+		Where("book_id = ?", bookID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete a subscription: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete a subscription: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetSubscriptions retrieves the subscriptions of the user, the oldest first
+func (r *SubscriptionRepository) GetSubscriptions(ctx context.Context, userID int) ([]domain.BookSubscription, error) {
+	var subscriptions []models.BookSubscription
+	err := r.db.NewSelect().Model(&subscriptions).
+		Where("user_id = ?", userID).
+		Order("created_at", "book_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	result := make([]domain.BookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		domainSubscription, err := bookSubscriptionToDomain(subscription)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain subscription: %w", err)
+		}
+		result = append(result, domainSubscription)
+	}
+
+	return result, nil
+}
+
+// ClaimPendingNotifications claims up to limit unsent notifications that have attempts left, the oldest first,
+// and returns them. Notifications claimed by another dispatcher are skipped unless the claim was made before claimedBefore.
+func (r *SubscriptionRepository) ClaimPendingNotifications(ctx context.Context, limit int, claimedBefore time.Time) ([]domain.StockNotification, error) {
+	var notifications []models.StockNotification
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		pending := tx.NewSelect().Model((*models.StockNotification)(nil)).
+			Column("id").
+			Where("sent_at IS NULL").
+			Where("attempts < ?", domain.MaxNotificationAttempts).
+			Where("claimed_at IS NULL OR claimed_at < ?", claimedBefore).
+			Order("id").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED")
+
+		var ids []int
+		err := tx.NewUpdate().Model((*models.StockNotification)(nil)).
+			Set("claimed_at = now()").
+			Where("id IN (?)", pending).
+			Returning("id").
+			Scan(ctx, &ids)
+		if err != nil {
+			return fmt.Errorf("failed to claim pending notifications: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		err = tx.NewSelect().Model(&notifications).
+			Relation("User").
+			Relation("Book").
+			Where("stock_notification.id IN (?)", bun.In(ids)).
+			Order("stock_notification.id").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get pending notifications: %w", err)
+		}
+
+		return nil
+	}, r.db.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.StockNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		domainNotification, err := stockNotificationToDomain(notification)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain notification: %w", err)
+		}
+		result = append(result, domainNotification)
+	}
+
+	return result, nil
+}
+
+// MarkNotificationSent records that the notification was delivered
+func (r *SubscriptionRepository) MarkNotificationSent(ctx context.Context, id int, sentAt time.Time) error {
+	_, err := r.db.NewUpdate().Model((*models.StockNotification)(nil)).
+		Set("sent_at = ?", sentAt).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification sent: %w", err)
+	}
+
+	return nil
+}
+
+// MarkNotificationFailed counts a failed attempt to send the notification, keeps the reason
+// and releases the claim, so the notification is retried by the next dispatch
+func (r *SubscriptionRepository) MarkNotificationFailed(ctx context.Context, id int, reason string) error {
+	_, err := r.db.NewUpdate().Model((*models.StockNotification)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", reason).
+		Set("claimed_at = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification failed: %w", err)
+	}
+
+	return nil
+}
+
+// queueBackInStock queues a notification for every subscriber of the books that came back in stock.
+// Subscriptions are dropped once queued, so a subscriber is notified once. Archived books are skipped, their stock
+// only comes back because their reservations were released.
+func queueBackInStock(ctx context.Context, tx bun.Tx, bookIDs []int) error {
+	if len(bookIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewRaw(`WITH subscriptions AS (
+			DELETE FROM ? WHERE book_id IN (?) AND book_id NOT IN (SELECT id FROM books WHERE archived_at IS NOT NULL)
+			RETURNING user_id, book_id
+		)
+		INSERT INTO ? (user_id, book_id) SELECT user_id, book_id FROM subscriptions`,
+		bun.Ident("book_subscriptions"), bun.In(bookIDs), bun.Ident("stock_notifications")).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to queue back in stock notifications: %w", err)
+	}
+
+	return nil
+}
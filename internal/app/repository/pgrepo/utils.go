@@ -7,39 +7,52 @@ import (
 
 func domainToBook(book domain.Book) models.Book {
 	return models.Book{
-		ID:         book.ID(),
-		Title:      book.Title(),
-		Year:       book.Year(),
-		Author:     book.Author(),
-		Price:      book.Price(),
-		Stock:      book.Stock(),
-		CategoryID: book.CategoryID(),
+		ID:          book.ID(),
+		Title:       book.Title(),
+		Year:        book.Year(),
+		Author:      book.Author(),
+		Price:       book.Price(),
+		Stock:       book.Stock(),
+		CategoryID:  book.CategoryID(),
+		CategoryIDs: book.CategoryIDs(),
+		ArchivedAt:  book.ArchivedAt(),
 	}
 }
 
 func bookToDomain(book models.Book) (domain.Book, error) {
 	return domain.NewBook(domain.NewBookData{
-		ID:         book.ID,
-		Title:      book.Title,
-		Year:       book.Year,
-		Author:     book.Author,
-		Price:      book.Price,
-		Stock:      book.Stock,
-		CategoryID: book.CategoryID,
+		ID:          book.ID,
+		Title:       book.Title,
+		Year:        book.Year,
+		Author:      book.Author,
+		Price:       book.Price,
+		Stock:       book.Stock,
+		CategoryID:  book.CategoryID,
+		CategoryIDs: book.CategoryIDs,
+		ArchivedAt:  book.ArchivedAt,
 	})
 }
 
 func domainToCategory(category domain.Category) models.Category {
 	return models.Category{
-		ID:   category.ID(),
-		Name: category.Name(),
+		ID:           category.ID(),
+		Name:         category.Name(),
+		ParentID:     category.ParentID(),
+		Slug:         category.Slug(),
+		Description:  category.Description(),
+		DisplayOrder: category.DisplayOrder(),
 	}
 }
 
 func categoryToDomain(category models.Category) (domain.Category, error) {
 	return domain.NewCategory(domain.NewCategoryData{
-		ID:   category.ID,
-		Name: category.Name,
+		ID:           category.ID,
+		Name:         category.Name,
+		ParentID:     category.ParentID,
+		Slug:         category.Slug,
+		Description:  category.Description,
+		DisplayOrder: category.DisplayOrder,
+		BookCount:    category.BookCount,
 	})
 }
 
@@ -61,16 +74,220 @@ func userToDomain(user models.User) (domain.User, error) {
 	})
 }
 
-func domainToCart(cart domain.Cart) models.Cart {
-	return models.Cart{
-		UserID:  cart.UserID(),
-		BookIDs: cart.BookIDs(),
+func cartItemToDomain(item models.CartItem) (domain.CartItem, error) {
+	data := domain.NewCartItemData{
+		BookID:     item.BookID,
+		Quantity:   item.Quantity,
+		ReservedAt: item.ReservedAt,
+		ExpiresAt:  item.ExpiresAt,
+	}
+	if item.Book != nil {
+		data.Title = item.Book.Title
+		data.Price = item.Book.Price
+	}
+
+	return domain.NewCartItem(data)
+}
+
+func guestCartItemToDomain(item models.GuestCartItem) (domain.CartItem, error) {
+	data := domain.NewCartItemData{
+		BookID:     item.BookID,
+		Quantity:   item.Quantity,
+		ReservedAt: item.ReservedAt,
+		ExpiresAt:  item.ExpiresAt,
+	}
+	if item.Book != nil {
+		data.Title = item.Book.Title
+		data.Price = item.Book.Price
+	}
+
+	return domain.NewCartItem(data)
+}
+
+func domainToOrder(order domain.Order) models.Order {
+	items := make([]models.OrderItem, 0, len(order.Items()))
+	for _, item := range order.Items() {
+		items = append(items, models.OrderItem{
+			OrderID:  order.ID(),
+			BookID:   item.BookID(),
+			Title:    item.Title(),
+			Price:    item.Price(),
+			Quantity: item.Quantity(),
+		})
+	}
+
+	return models.Order{
+		ID:         order.ID(),
+		UserID:     order.UserID(),
+		Total:      order.Total(),
+		Status:     string(order.Status()),
+		PaymentID:  order.PaymentID(),
+		RefundedAt: order.RefundedAt(),
+		Items:      items,
+		CreatedAt:  order.CreatedAt(),
+		UpdatedAt:  order.UpdatedAt(),
+	}
+}
+
+func orderToDomain(order models.Order) (domain.Order, error) {
+	items := make([]domain.NewOrderItemData, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, domain.NewOrderItemData{
+			BookID:   item.BookID,
+			Title:    item.Title,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		})
+	}
+
+	history := make([]domain.NewOrderTransitionData, 0, len(order.History))
+	for _, transition := range order.History {
+		history = append(history, domain.NewOrderTransitionData{
+			From:      domain.OrderStatus(transition.FromStatus),
+			To:        domain.OrderStatus(transition.ToStatus),
+			ActorID:   transition.ActorID,
+			CreatedAt: transition.CreatedAt,
+		})
+	}
+
+	return domain.NewOrder(domain.NewOrderData{
+		ID:         order.ID,
+		UserID:     order.UserID,
+		Items:      items,
+		Status:     domain.OrderStatus(order.Status),
+		PaymentID:  order.PaymentID,
+		RefundedAt: order.RefundedAt,
+		History:    history,
+		CreatedAt:  order.CreatedAt,
+		UpdatedAt:  order.UpdatedAt,
+	})
+}
+
+func domainToOrderTransition(orderID int, transition domain.OrderTransition) models.OrderTransition {
+	return models.OrderTransition{
+		OrderID:    orderID,
+		FromStatus: string(transition.From()),
+		ToStatus:   string(transition.To()),
+		ActorID:    transition.ActorID(),
+		CreatedAt:  transition.CreatedAt(),
+	}
+}
+
+func domainToIdempotencyKey(key domain.IdempotencyKey) models.IdempotencyKey {
+	return models.IdempotencyKey{
+		UserID:      key.UserID(),
+		Key:         key.Key(),
+		RequestHash: key.RequestHash(),
+		StatusCode:  key.StatusCode(),
+		Response:    key.Response(),
+		CreatedAt:   key.CreatedAt(),
+		CompletedAt: key.CompletedAt(),
+	}
+}
+
+func idempotencyKeyToDomain(key models.IdempotencyKey) (domain.IdempotencyKey, error) {
+	return domain.NewIdempotencyKey(domain.NewIdempotencyKeyData{
+		Key:         key.Key,
+		UserID:      key.UserID,
+		RequestHash: key.RequestHash,
+		StatusCode:  key.StatusCode,
+		Response:    key.Response,
+		CreatedAt:   key.CreatedAt,
+		CompletedAt: key.CompletedAt,
+	})
+}
+
+func domainToWishlist(wishlist domain.Wishlist) models.Wishlist {
+	items := make([]models.WishlistItem, 0, len(wishlist.Items()))
+	for _, item := range wishlist.Items() {
+		items = append(items, models.WishlistItem{
+			WishlistID: wishlist.ID(),
+			BookID:     item.BookID(),
+			AddedAt:    item.AddedAt(),
+		})
+	}
+
+	return models.Wishlist{
+		ID:        wishlist.ID(),
+		UserID:    wishlist.UserID(),
+		Name:      wishlist.Name(),
+		Items:     items,
+		CreatedAt: wishlist.CreatedAt(),
+		UpdatedAt: wishlist.UpdatedAt(),
+	}
+}
+
+func wishlistToDomain(wishlist models.Wishlist) (domain.Wishlist, error) {
+	items := make([]domain.NewWishlistItemData, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		data := domain.NewWishlistItemData{
+			BookID:  item.BookID,
+			AddedAt: item.AddedAt,
+		}
+		if item.Book != nil {
+			data.Title = item.Book.Title
+			data.Price = item.Book.Price
+			data.Stock = item.Book.Stock
+		}
+		items = append(items, data)
+	}
+
+	return domain.NewWishlist(domain.NewWishlistData{
+		ID:        wishlist.ID,
+		UserID:    wishlist.UserID,
+		Name:      wishlist.Name,
+		Items:     items,
+		CreatedAt: wishlist.CreatedAt,
+		UpdatedAt: wishlist.UpdatedAt,
+	})
+}
+
+func bookSubscriptionToDomain(subscription models.BookSubscription) (domain.BookSubscription, error) {
+	return domain.NewBookSubscription(domain.NewBookSubscriptionData{
+		UserID:    subscription.UserID,
+		BookID:    subscription.BookID,
+		CreatedAt: subscription.CreatedAt,
+	})
+}
+
+func stockNotificationToDomain(notification models.StockNotification) (domain.StockNotification, error) {
+	data := domain.NewStockNotificationData{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		BookID:    notification.BookID,
+		Attempts:  notification.Attempts,
+		CreatedAt: notification.CreatedAt,
+	}
+	if notification.User != nil {
+		data.Email = notification.User.Email
+	}
+	if notification.Book != nil {
+		data.Title = notification.Book.Title
+	}
+
+	return domain.NewStockNotification(data)
+}
+
+func domainToInventoryMovement(movement domain.InventoryMovement) models.InventoryMovement {
+	return models.InventoryMovement{
+		ID:        movement.ID(),
+		BookID:    movement.BookID(),
+		Kind:      string(movement.Kind()),
+		Quantity:  movement.Quantity(),
+		Reason:    movement.Reason(),
+		ActorID:   movement.ActorID(),
+		CreatedAt: movement.CreatedAt(),
 	}
 }
 
-func cartToDomain(cart models.Cart) (domain.Cart, error) {
-	return domain.NewCart(domain.NewCartData{
-		UserID:  cart.UserID,
-		BookIDs: cart.BookIDs,
+func inventoryMovementToDomain(movement models.InventoryMovement) (domain.InventoryMovement, error) {
+	return domain.NewInventoryMovement(domain.NewInventoryMovementData{
+		ID:        movement.ID,
+		BookID:    movement.BookID,
+		Kind:      domain.MovementKind(movement.Kind),
+		Quantity:  movement.Quantity,
+		Reason:    movement.Reason,
+		ActorID:   movement.ActorID,
+		CreatedAt: movement.CreatedAt,
 	})
 }
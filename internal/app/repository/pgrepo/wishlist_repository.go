@@ -0,0 +1,210 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/repository/models"
+	"toptal/internal/pkg/pg"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+type WishlistRepository struct {
+	db *pg.DB
+}
+
+// NewWishlistRepository creates a new wishlist repository instance
+func NewWishlistRepository(db *pg.DB) *WishlistRepository {
+	return &WishlistRepository{db: db}
+}
+
+// CreateWishlist creates an empty wishlist, the names of the wishlists of a user are unique
+func (r *WishlistRepository) CreateWishlist(ctx context.Context, wishlist domain.Wishlist) (domain.Wishlist, error) {
+	dbWishlist := domainToWishlist(wishlist)
+	dbWishlist.Items = nil
+
+	err := r.db.NewInsert().Model(&dbWishlist).Returning("*").Scan(ctx)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Wishlist{}, wishlistExistsError()
+		}
+		return domain.Wishlist{}, fmt.Errorf("failed to insert a wishlist: %w", err)
+	}
+
+	domainWishlist, err := wishlistToDomain(dbWishlist)
+	if err != nil {
+		return domain.Wishlist{}, fmt.Errorf("failed to create domain wishlist: %w", err)
+	}
+
+	return domainWishlist, nil
+}
+
+// GetWishlist retrieves a wishlist with its books by ID
+func (r *WishlistRepository) GetWishlist(ctx context.Context, id int) (domain.Wishlist, error) {
+	return getWishlist(ctx, r.db, id, false)
+}
+
+// GetWishlists retrieves the wishlists of the user ordered by name
+func (r *WishlistRepository) GetWishlists(ctx context.Context, userID int) ([]domain.Wishlist, error) {
+	var wishlists []models.Wishlist
+	err := r.db.NewSelect().Model(&wishlists).
+		Relation("Items", wishlistItemsByAddedAt).
+		Relation("Items.Book").
+		Where("wishlist.user_id = ?", userID).
+		Order("wishlist.name", "wishlist.id").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlists: %w", err)
+	}
+
+	domainWishlists := make([]domain.Wishlist, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		domainWishlist, err := wishlistToDomain(wishlist)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain wishlist: %w", err)
+		}
+		domainWishlists = append(domainWishlists, domainWishlist)
+	}
+
+	return domainWishlists, nil
+}
+
+// UpdateWishlist locks the wishlist, applies updateFn to it and saves its name and the books
+// updateFn has added or removed. The stock of the books is not touched.
+func (r *WishlistRepository) UpdateWishlist(ctx context.Context, id int, updateFn func(wishlist *domain.Wishlist) error) (domain.Wishlist, error) {
+	var wishlist domain.Wishlist
+	err := pg.HandleBunTransaction(ctx, func(tx bun.Tx) error {
+		oldWishlist, err := getWishlist(ctx, tx, id, true)
+		if err != nil {
+			return err
+		}
+
+		wishlist = oldWishlist
+		err = updateFn(&wishlist)
+		if err != nil {
+			return err
+		}
+
+		dbWishlist := domainToWishlist(wishlist)
+		_, err = tx.NewUpdate().Model(&dbWishlist).
+			Column("name", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return wishlistExistsError()
+			}
+			return fmt.Errorf("failed to update a wishlist: %w", err)
+		}
+
+		var added []models.WishlistItem
+		for _, item := range dbWishlist.Items {
+			if !oldWishlist.HasBook(item.BookID) {
+				added = append(added, item)
+			}
+		}
+		var removed []int
+		for _, bookID := range oldWishlist.BookIDs() {
+			if !wishlist.HasBook(bookID) {
+				removed = append(removed, bookID)
+			}
+		}
+
+		if len(added) > 0 {
+			addedIDs := make([]int, 0, len(added))
+			for _, item := range added {
+				addedIDs = append(addedIDs, item.BookID)
+			}
+			count, err := tx.NewSelect().Model((*models.Book)(nil)).Where("id IN (?)", bun.In(addedIDs)).Count(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check books: %w", err)
+			}
+			if count != len(addedIDs) {
+				return slugerrors.NewNotFoundError("book not found", "book-not-found")
+			}
+
+			_, err = tx.NewInsert().Model(&added).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to add wishlist items: %w", err)
+			}
+		}
+		if len(removed) > 0 {
+			_, err := tx.NewDelete().Model((*models.WishlistItem)(nil)).
+				Where("wishlist_id = ?", id).
+				Where("book_id IN (?)", bun.In(removed)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to delete wishlist items: %w", err)
+			}
+		}
+
+		wishlist, err = getWishlist(ctx, tx, id, false)
+		return err
+	}, r.db.DB)
+	if err != nil {
+		return domain.Wishlist{}, fmt.Errorf("failed to update wishlist: %w", err)
+	}
+
+	return wishlist, nil
+}
+
+// DeleteWishlist deletes a wishlist with its books
+func (r *WishlistRepository) DeleteWishlist(ctx context.Context, id int) error {
+	_, err := r.db.NewDelete().Model((*models.Wishlist)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete a wishlist: %w", err)
+	}
+
+	return nil
+}
+
+// getWishlist retrieves a wishlist with its books, forUpdate locks the wishlist row until the end of the transaction
+func getWishlist(ctx context.Context, db bun.IDB, id int, forUpdate bool) (domain.Wishlist, error) {
+	var wishlist models.Wishlist
+	query := db.NewSelect().Model(&wishlist).Where("wishlist.id = ?", id)
+	if forUpdate {
+		query.For("UPDATE OF wishlist")
+	}
+	err := query.Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Wishlist{}, domain.ErrNotFound
+		}
+		return domain.Wishlist{}, fmt.Errorf("failed to get a wishlist: %w", err)
+	}
+
+	err = db.NewSelect().Model(&wishlist.Items).
+		Relation("Book").
+		Where("wishlist_item.wishlist_id = ?", id).
+		Order("wishlist_item.added_at", "wishlist_item.book_id").
+		Scan(ctx)
+	if err != nil {
+		return domain.Wishlist{}, fmt.Errorf("failed to get wishlist items: %w", err)
+	}
+
+	domainWishlist, err := wishlistToDomain(wishlist)
+	if err != nil {
+		return domain.Wishlist{}, fmt.Errorf("failed to create domain wishlist: %w", err)
+	}
+
+	return domainWishlist, nil
+}
+
+func wishlistItemsByAddedAt(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("added_at", "book_id")
+}
+
+func wishlistExistsError() error {
+	return slugerrors.NewBadRequestError("a wishlist with this name already exists", "wishlist-exists")
+}
+
+// isUniqueViolation reports whether the query failed on a unique constraint
+func isUniqueViolation(err error) bool {
+	var pgErr pgdriver.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == "23505"
+}
@@ -3,7 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
+	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
+	"unicode/utf8"
 )
 
 type BookService struct {
@@ -38,6 +41,39 @@ func (s BookService) DeleteBook(ctx context.Context, id int) error {
 	return s.repo.DeleteBook(ctx, id)
 }
 
-func (s BookService) GetBooks(ctx context.Context, categoryIDs []int, limit, offset int) ([]domain.Book, error) {
-	return s.repo.GetBooks(ctx, categoryIDs, limit, offset)
+// GetBooks lists the books matching the filter, with a query they are searched by title and author and ranked by relevance.
+// Only admins are allowed to list the books that are sold out, the actor is the zero user for anonymous visitors.
+func (s BookService) GetBooks(ctx context.Context, actor domain.User, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error) {
+	if filter.IncludeSoldOut() && !actor.Admin() {
+		return domain.Page[domain.Book]{}, slugerrors.NewAuthorizationError("only admins can list sold out books", "not-admin")
+	}
+
+	return s.repo.GetBooks(ctx, filter, page)
+}
+
+// GetBookFacets counts the books matching the filter per category, decade and price bucket to narrow the listing down.
+// Like the listing, only admins are allowed to count the books that are sold out.
+func (s BookService) GetBookFacets(ctx context.Context, actor domain.User, filter domain.BookFilter) (domain.BookFacets, error) {
+	if filter.IncludeSoldOut() && !actor.Admin() {
+		return domain.BookFacets{}, slugerrors.NewAuthorizationError("only admins can count sold out books", "not-admin")
+	}
+
+	return s.repo.GetBookFacets(ctx, filter)
+}
+
+// SuggestBooks returns the titles and authors resembling the prefix the user is typing, typos included
+func (s BookService) SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error) {
+	prefix = strings.TrimSpace(prefix)
+	length := utf8.RuneCountInString(prefix)
+	if length < domain.MinSuggestPrefixLength || length > domain.MaxSuggestPrefixLength {
+		return nil, fmt.Errorf("%w: from %d to %d characters", domain.ErrInvalidSuggestPrefix, domain.MinSuggestPrefixLength, domain.MaxSuggestPrefixLength)
+	}
+	if limit == 0 {
+		limit = domain.DefaultSuggestLimit
+	}
+	if limit < 0 || limit > domain.MaxSuggestLimit {
+		return nil, fmt.Errorf("%w: from 1 to %d", domain.ErrInvalidLimit, domain.MaxSuggestLimit)
+	}
+
+	return s.repo.SuggestBooks(ctx, prefix, limit)
 }
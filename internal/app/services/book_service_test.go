@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/services/mocks"
 
@@ -82,26 +84,29 @@ func TestBookService_GetBooks_Success(t *testing.T) {
 	mockRepo := mocks.NewMockBookRepository(t)
 	service := NewBookService(mockRepo)
 	ctx := context.Background()
-	categoryIDs := []int{1, 2}
-	limit := 10
-	offset := 0
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{CategoryIDs: []int{1, 2}})
+	require.NoError(t, err)
+	pageRequest, err := domain.NewPageRequest(domain.NewPageRequestData{Size: 10})
+	require.NoError(t, err)
 
-	expectedBooks := []domain.Book{
+	expectedBooks, err := domain.NewPage(domain.NewPageData[domain.Book]{
 		// создайте тестовые книги через domain.NewBook()
-	}
+		Items: []domain.Book{},
+	})
+	require.NoError(t, err)
 
 	mockRepo.EXPECT().
-		GetBooks(ctx, categoryIDs, limit, offset).
+		GetBooks(ctx, filter, pageRequest).
 		Return(expectedBooks, nil).
 		Once()
 
 	// Act
-	result, err := service.GetBooks(ctx, categoryIDs, limit, offset)
+	result, err := service.GetBooks(ctx, domain.User{}, filter, pageRequest)
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedBooks, result)
-	assert.Len(t, result, len(expectedBooks))
+	assert.Len(t, result.Items(), len(expectedBooks.Items()))
 }
 
 func TestBookService_GetBooks_EmptyResult(t *testing.T) {
@@ -111,16 +116,16 @@ func TestBookService_GetBooks_EmptyResult(t *testing.T) {
 	ctx := context.Background()
 
 	mockRepo.EXPECT().
-		GetBooks(ctx, []int{}, 0, 0).
-		Return([]domain.Book{}, nil).
+		GetBooks(ctx, domain.BookFilter{}, domain.PageRequest{}).
+		Return(domain.Page[domain.Book]{}, nil).
 		Once()
 
 	// Act
-	result, err := service.GetBooks(ctx, []int{}, 0, 0)
+	result, err := service.GetBooks(ctx, domain.User{}, domain.BookFilter{}, domain.PageRequest{})
 
 	// Assert
 	require.NoError(t, err)
-	assert.Empty(t, result)
+	assert.Empty(t, result.Items())
 }
 
 func TestBookService_GetBooks_RepositoryError(t *testing.T) {
@@ -131,17 +136,17 @@ func TestBookService_GetBooks_RepositoryError(t *testing.T) {
 	expectedError := errors.New("database connection failed")
 
 	mockRepo.EXPECT().
-		GetBooks(ctx, []int{}, 0, 0).
-		Return(nil, expectedError).
+		GetBooks(ctx, domain.BookFilter{}, domain.PageRequest{}).
+		Return(domain.Page[domain.Book]{}, expectedError).
 		Once()
 
 	// Act
-	result, err := service.GetBooks(ctx, []int{}, 0, 0)
+	result, err := service.GetBooks(ctx, domain.User{}, domain.BookFilter{}, domain.PageRequest{})
 
 	// Assert
 	require.Error(t, err)
 	assert.Equal(t, expectedError, err)
-	assert.Nil(t, result)
+	assert.Nil(t, result.Items())
 }
 
 func TestBookService_CreateBook_Success(t *testing.T) {
@@ -263,16 +268,152 @@ func TestBookService_GetBooks_InvalidCategory(t *testing.T) {
 
 	expectedError := errors.New("category not found")
 
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{CategoryIDs: []int{1999}})
+	require.NoError(t, err)
+
 	mockRepo.EXPECT().
-		GetBooks(ctx, []int{1999}, 10, 0).
-		Return(nil, expectedError).
+		GetBooks(ctx, filter, domain.PageRequest{}).
+		Return(domain.Page[domain.Book]{}, expectedError).
 		Once()
 
 	// Act
-	result, err := service.GetBooks(ctx, []int{1999}, 10, 0)
+	result, err := service.GetBooks(ctx, domain.User{}, filter, domain.PageRequest{})
 
 	// Assert
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "category not found")
-	assert.Nil(t, result)
+	assert.Nil(t, result.Items())
+}
+
+func TestBookService_GetBooks_SoldOutForAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockBookRepository(t)
+	service := NewBookService(mockRepo)
+	ctx := context.Background()
+	admin, err := domain.NewUserFromToken(domain.NewUserData{ID: 1, Email: "admin@example.com", Admin: true})
+	require.NoError(t, err)
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{IncludeSoldOut: true})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetBooks(ctx, filter, domain.PageRequest{}).
+		Return(domain.Page[domain.Book]{}, nil).
+		Once()
+
+	// Act
+	_, err = service.GetBooks(ctx, admin, filter, domain.PageRequest{})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestBookService_GetBooks_SoldOutNotAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockBookRepository(t)
+	service := NewBookService(mockRepo)
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{IncludeSoldOut: true})
+	require.NoError(t, err)
+
+	// Act
+	result, err := service.GetBooks(context.Background(), domain.User{}, filter, domain.PageRequest{})
+
+	// Assert
+	var slugError slugerrors.SlugError
+	require.ErrorAs(t, err, &slugError)
+	assert.Equal(t, "not-admin", slugError.Slug())
+	assert.Nil(t, result.Items())
+}
+
+func TestBookService_GetBookFacets_Success(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockBookRepository(t)
+	service := NewBookService(mockRepo)
+	ctx := context.Background()
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{Query: "dune"})
+	require.NoError(t, err)
+	expectedFacets, err := domain.NewBookFacets(domain.NewBookFacetsData{
+		Categories: []domain.CategoryFacetData{{CategoryID: 1, Name: "Fantasy", Count: 3}},
+		Decades:    []domain.DecadeFacetData{{Decade: 1960, Count: 3}},
+		Prices:     []domain.PriceFacetData{{Bucket: 2, Count: 3}},
+	})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetBookFacets(ctx, filter).
+		Return(expectedFacets, nil).
+		Once()
+
+	// Act
+	result, err := service.GetBookFacets(ctx, domain.User{}, filter)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFacets, result)
+}
+
+func TestBookService_GetBookFacets_SoldOutNotAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockBookRepository(t)
+	service := NewBookService(mockRepo)
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{IncludeSoldOut: true})
+	require.NoError(t, err)
+
+	// Act
+	result, err := service.GetBookFacets(context.Background(), domain.User{}, filter)
+
+	// Assert
+	var slugError slugerrors.SlugError
+	require.ErrorAs(t, err, &slugError)
+	assert.Equal(t, "not-admin", slugError.Slug())
+	assert.Empty(t, result.Categories())
+}
+
+func TestBookService_SuggestBooks_DefaultLimit(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockBookRepository(t)
+	service := NewBookService(mockRepo)
+	ctx := context.Background()
+	suggestion, err := domain.NewBookSuggestion(domain.NewBookSuggestionData{Text: "J. R. R. Tolkien", Kind: domain.SuggestionAuthor, Score: 0.5})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		SuggestBooks(ctx, "Tolkein", domain.DefaultSuggestLimit).
+		Return([]domain.BookSuggestion{suggestion}, nil).
+		Once()
+
+	// Act
+	result, err := service.SuggestBooks(ctx, " Tolkein ", 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []domain.BookSuggestion{suggestion}, result)
+}
+
+func TestBookService_SuggestBooks_InvalidInput(t *testing.T) {
+	testCases := []struct {
+		name        string
+		prefix      string
+		limit       int
+		expectedErr error
+	}{
+		{"Short prefix", " T ", 5, domain.ErrInvalidSuggestPrefix},
+		{"Long prefix", strings.Repeat("a", domain.MaxSuggestPrefixLength+1), 5, domain.ErrInvalidSuggestPrefix},
+		{"Negative limit", "Tolkein", -1, domain.ErrInvalidLimit},
+		{"Large limit", "Tolkein", domain.MaxSuggestLimit + 1, domain.ErrInvalidLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockBookRepository(t)
+			service := NewBookService(mockRepo)
+
+			// Act
+			result, err := service.SuggestBooks(context.Background(), tc.prefix, tc.limit)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.Nil(t, result)
+		})
+	}
 }
@@ -3,40 +3,182 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
-
-	"github.com/davecgh/go-spew/spew"
 )
 
 type CartService struct {
-	cartRepo CartRepository
+	cartRepo       CartRepository
+	orderRepo      OrderRepository
+	payments       PaymentProvider
+	paymentTimeout time.Duration
 }
 
 // NewCartService creates a new cart service instance
-func NewCartService(cartRepo CartRepository) *CartService {
+func NewCartService(cartRepo CartRepository, orderRepo OrderRepository, payments PaymentProvider, paymentTimeout time.Duration) *CartService {
 	return &CartService{
-		cartRepo: cartRepo,
+		cartRepo:       cartRepo,
+		orderRepo:      orderRepo,
+		payments:       payments,
+		paymentTimeout: paymentTimeout,
+	}
+}
+
+// GetCart returns the books in the user's cart, an empty cart has no items
+func (s CartService) GetCart(ctx context.Context, userID int) ([]domain.CartItem, error) {
+	items, err := s.cartRepo.GetCartItems(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart items: %w", err)
 	}
+
+	return items, nil
 }
 
-// UpdateCart updates a cart
-func (s CartService) UpdateCartAndStocks(ctx context.Context, cart domain.Cart) (domain.Cart, error) {
+// UpdateCartAndStocks replaces the books in the cart and returns the updated cart
+func (s CartService) UpdateCartAndStocks(ctx context.Context, cart domain.Cart) ([]domain.CartItem, error) {
 	err := s.cartRepo.UpdateCartAndStocks(ctx, cart)
 	if err != nil {
-		return domain.Cart{}, fmt.Errorf("failed to update cart and stocks: %w", err)
+		return nil, fmt.Errorf("failed to update cart and stocks: %w", err)
+	}
+
+	return s.GetCart(ctx, cart.UserID())
+}
+
+// AddBook reserves quantity copies of a book in the user's cart, replacing the quantity
+// that was there before, and returns the updated cart
+func (s CartService) AddBook(ctx context.Context, userID, bookID, quantity int) ([]domain.CartItem, error) {
+	if bookID <= 0 {
+		return nil, fmt.Errorf("%w: book_id", domain.ErrNegative)
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: %d", domain.ErrInvalidQuantity, quantity)
+	}
+
+	err := s.cartRepo.UpdateCart(ctx, userID, func(cart *domain.Cart) error {
+		return cart.SetQuantity(bookID, quantity)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add book to cart: %w", err)
+	}
+
+	return s.GetCart(ctx, userID)
+}
+
+// RemoveBook releases a book from the user's cart and returns the updated cart
+func (s CartService) RemoveBook(ctx context.Context, userID, bookID int) ([]domain.CartItem, error) {
+	if bookID <= 0 {
+		return nil, fmt.Errorf("%w: book_id", domain.ErrNegative)
+	}
+
+	err := s.cartRepo.UpdateCart(ctx, userID, func(cart *domain.Cart) error {
+		cart.RemoveBook(bookID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove book from cart: %w", err)
+	}
+
+	return s.GetCart(ctx, userID)
+}
+
+// ClearCart empties the user's cart and releases every reservation
+func (s CartService) ClearCart(ctx context.Context, userID int) error {
+	err := s.cartRepo.ReleaseCart(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear cart: %w", err)
+	}
+
+	return nil
+}
+
+// Checkout turns the cart into a pending order, charges the user for it and marks it paid.
+// The payment runs outside of the database transactions. When it's declined or times out the pending order
+// is cancelled and the books of the cart are put back in stock, when the order can't be marked paid
+// the captured money is refunded.
+func (s CartService) Checkout(ctx context.Context, userID int) (domain.Order, error) {
+	order, err := s.orderRepo.CreateOrderFromCart(ctx, userID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	paymentID, err := s.pay(ctx, order)
+	if err != nil {
+		return domain.Order{}, s.cancelUnpaidOrder(ctx, order, err)
+	}
+
+	paidOrder, err := s.orderRepo.UpdateOrder(ctx, order.ID(), func(order *domain.Order) error {
+		return order.MarkPaid(paymentID, userID, time.Now())
+	})
+	if err != nil {
+		// the compensation has to finish even when the request is gone
+		ctx := context.WithoutCancel(ctx)
+		refundErr := s.payments.Refund(ctx, paymentID, order.Total())
+		if refundErr != nil {
+			return domain.Order{}, fmt.Errorf("failed to mark order %d paid: %w, and to refund payment %s: %w", order.ID(), err, paymentID, refundErr)
+		}
+		return domain.Order{}, s.cancelUnpaidOrder(ctx, order, fmt.Errorf("failed to mark order paid: %w", err))
 	}
 
-	updatedCart, err := s.cartRepo.GetCart(ctx, cart.UserID())
-	spew.Dump(err)
+	return paidOrder, nil
+}
+
+// cancelUnpaidOrder cancels the pending order of a failed checkout, which puts its books back in stock,
+// and returns the error of the checkout
+func (s CartService) cancelUnpaidOrder(ctx context.Context, order domain.Order, checkoutErr error) error {
+	ctx = context.WithoutCancel(ctx)
+	_, err := s.orderRepo.UpdateOrder(ctx, order.ID(), func(order *domain.Order) error {
+		// the customer may have cancelled it in the meantime
+		if order.Status() == domain.OrderStatusCancelled {
+			return nil
+		}
+		return order.ChangeStatus(domain.OrderStatusCancelled, order.UserID(), time.Now())
+	})
 	if err != nil {
-		return domain.Cart{}, fmt.Errorf("failed to get updated cart: %w", err)
+		return fmt.Errorf("failed to cancel unpaid order %d: %w, after: %w", order.ID(), err, checkoutErr)
 	}
 
-	return updatedCart, nil
+	switch {
+	case errors.Is(checkoutErr, domain.ErrPaymentTimeout):
+		return slugerrors.NewTimeoutError("payment provider did not respond in time", "payment-timeout")
+	case errors.Is(checkoutErr, domain.ErrPaymentDeclined):
+		return slugerrors.NewBadRequestError("payment was declined", "payment-declined")
+	default:
+		return fmt.Errorf("failed to pay for order: %w", checkoutErr)
+	}
 }
 
-// Checkout does nothing but cleans up the cart as per the spec
-func (s CartService) Checkout(ctx context.Context, userID int) error {
-	return s.cartRepo.DeleteCart(ctx, userID)
+// pay authorizes and captures the order total and returns the payment reference.
+// An authorization whose capture fails is voided, so is any authorization of the order when Authorize times out.
+func (s CartService) pay(ctx context.Context, order domain.Order) (string, error) {
+	payCtx := ctx
+	if s.paymentTimeout > 0 {
+		var cancel context.CancelFunc
+		payCtx, cancel = context.WithTimeout(ctx, s.paymentTimeout)
+		defer cancel()
+	}
+
+	paymentID, err := s.payments.Authorize(payCtx, order)
+	if err != nil {
+		if errors.Is(err, domain.ErrPaymentTimeout) {
+			voidErr := s.payments.VoidOrder(context.WithoutCancel(ctx), order.ID())
+			if voidErr != nil {
+				return "", fmt.Errorf("failed to authorize payment: %w, and to void the authorizations of order %d: %w", err, order.ID(), voidErr)
+			}
+		}
+		return "", fmt.Errorf("failed to authorize payment: %w", err)
+	}
+
+	err = s.payments.Capture(payCtx, paymentID, order.Total())
+	if err != nil {
+		voidErr := s.payments.Void(context.WithoutCancel(ctx), paymentID)
+		if voidErr != nil {
+			return "", fmt.Errorf("failed to capture payment: %w, and to void it: %w", err, voidErr)
+		}
+		return "", fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	return paymentID, nil
 }
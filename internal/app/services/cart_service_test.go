@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/payment"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartService_AddBook_ReservesBook(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+	ctx := context.Background()
+
+	var updatedCart domain.Cart
+	mockRepo.EXPECT().
+		UpdateCart(ctx, 7, mock.Anything).
+		RunAndReturn(func(_ context.Context, userID int, updateFn func(cart *domain.Cart) error) error {
+			cart, err := domain.NewCart(domain.NewCartData{UserID: userID, BookIDs: []int{1}})
+			require.NoError(t, err)
+			err = updateFn(&cart)
+			updatedCart = cart
+			return err
+		}).
+		Once()
+
+	item, err := domain.NewCartItem(domain.NewCartItemData{BookID: 2, Title: "Clean Architecture", Price: 2999, Quantity: 3, ExpiresAt: time.Now()})
+	require.NoError(t, err)
+	mockRepo.EXPECT().
+		GetCartItems(ctx, 7).
+		Return([]domain.CartItem{item}, nil).
+		Once()
+
+	// Act
+	items, err := service.AddBook(ctx, 7, 2, 3)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, updatedCart.BookIDs())
+	assert.Equal(t, 3, updatedCart.Quantity(2))
+	assert.Equal(t, []domain.CartItem{item}, items)
+}
+
+func TestCartService_RemoveBook_ReleasesBook(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+	ctx := context.Background()
+
+	var updatedCart domain.Cart
+	mockRepo.EXPECT().
+		UpdateCart(ctx, 7, mock.Anything).
+		RunAndReturn(func(_ context.Context, userID int, updateFn func(cart *domain.Cart) error) error {
+			cart, err := domain.NewCart(domain.NewCartData{UserID: userID, BookIDs: []int{1, 2}})
+			require.NoError(t, err)
+			err = updateFn(&cart)
+			updatedCart = cart
+			return err
+		}).
+		Once()
+	mockRepo.EXPECT().
+		GetCartItems(ctx, 7).
+		Return([]domain.CartItem{}, nil).
+		Once()
+
+	// Act
+	_, err := service.RemoveBook(ctx, 7, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, updatedCart.BookIDs())
+}
+
+func TestCartService_AddBook_InvalidBookID(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+
+	// Act
+	items, err := service.AddBook(context.Background(), 7, 0, 1)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrNegative)
+	assert.Nil(t, items)
+	mockRepo.AssertNotCalled(t, "UpdateCart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCartService_AddBook_InvalidQuantity(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+
+	// Act
+	items, err := service.AddBook(context.Background(), 7, 2, 0)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrInvalidQuantity)
+	assert.Nil(t, items)
+	mockRepo.AssertNotCalled(t, "UpdateCart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCartService_Checkout_PaysPendingOrder(t *testing.T) {
+	// Arrange
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	provider := payment.NewFakeProvider()
+	service := NewCartService(mockCartRepo, mockOrderRepo, provider, time.Second)
+	ctx := context.Background()
+	order := newTestOrder(t, 7, domain.OrderStatusPending, "")
+
+	mockOrderRepo.EXPECT().
+		CreateOrderFromCart(ctx, 7).
+		Return(order, nil).
+		Once()
+	saved := expectOrderUpdates(t, mockOrderRepo, order)
+
+	// Act
+	result, err := service.Checkout(ctx, 7)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusPaid, result.Status())
+	assert.Equal(t, domain.OrderStatusPaid, saved.Status())
+	assert.True(t, provider.Captured(result.PaymentID()))
+}
+
+func TestCartService_Checkout_FailedPayment_CancelsPendingOrder(t *testing.T) {
+	testCases := []struct {
+		name         string
+		outcome      payment.Outcome
+		expectedSlug string
+		expectedType slugerrors.ErrorType
+	}{
+		{"Declined", payment.Decline, "payment-declined", slugerrors.ErrorTypeBadRequest},
+		{"Timed out", payment.Timeout, "payment-timeout", slugerrors.ErrorTypeTimeout},
+		{"Capture failed", payment.FailCapture, "", slugerrors.ErrorType{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockCartRepo := mocks.NewMockCartRepository(t)
+			mockOrderRepo := mocks.NewMockOrderRepository(t)
+			provider := payment.NewFakeProvider(tc.outcome)
+			service := NewCartService(mockCartRepo, mockOrderRepo, provider, 10*time.Millisecond)
+			ctx := context.Background()
+			order := newTestOrder(t, 7, domain.OrderStatusPending, "")
+
+			mockOrderRepo.EXPECT().
+				CreateOrderFromCart(ctx, 7).
+				Return(order, nil).
+				Once()
+			// cancelling the pending order puts its books back in stock
+			saved := expectOrderUpdates(t, mockOrderRepo, order)
+
+			// Act
+			result, err := service.Checkout(ctx, 7)
+
+			// Assert
+			require.Error(t, err)
+			assert.Equal(t, domain.Order{}, result)
+			assert.Equal(t, domain.OrderStatusCancelled, saved.Status())
+			assert.Empty(t, saved.PaymentID())
+			assert.False(t, provider.Held(order.ID()), "no authorization holds the money")
+			var slugError slugerrors.SlugError
+			if tc.expectedSlug == "" {
+				assert.False(t, errors.As(err, &slugError))
+				assert.True(t, provider.Voided("fake_1"), "the authorization is voided")
+				return
+			}
+			require.ErrorAs(t, err, &slugError)
+			assert.Equal(t, tc.expectedSlug, slugError.Slug())
+			assert.Equal(t, tc.expectedType, slugError.ErrorType())
+		})
+	}
+}
+
+func TestCartService_Checkout_OrderNotMarkedPaid_RefundsPayment(t *testing.T) {
+	// Arrange
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	mockOrderRepo := mocks.NewMockOrderRepository(t)
+	provider := payment.NewFakeProvider()
+	service := NewCartService(mockCartRepo, mockOrderRepo, provider, time.Second)
+	ctx := context.Background()
+	order := newTestOrder(t, 7, domain.OrderStatusPending, "")
+
+	mockOrderRepo.EXPECT().
+		CreateOrderFromCart(ctx, 7).
+		Return(order, nil).
+		Once()
+	mockOrderRepo.EXPECT().
+		UpdateOrder(ctx, order.ID(), mock.Anything).
+		Return(domain.Order{}, errors.New("connection lost")).
+		Once()
+	saved := expectOrderUpdates(t, mockOrderRepo, order)
+
+	// Act
+	_, err := service.Checkout(ctx, 7)
+
+	// Assert
+	require.Error(t, err)
+	assert.True(t, provider.Refunded("fake_1"))
+	assert.Equal(t, domain.OrderStatusCancelled, saved.Status())
+}
@@ -24,6 +24,13 @@ func (s CategoryService) GetCategory(ctx context.Context, id int) (domain.Catego
 	return s.repo.GetCategory(ctx, id)
 }
 
+func (s CategoryService) GetCategoryBySlug(ctx context.Context, slug string) (domain.Category, error) {
+	if slug == "" {
+		return domain.Category{}, fmt.Errorf("%w: slug", domain.ErrRequired)
+	}
+	return s.repo.GetCategoryBySlug(ctx, slug)
+}
+
 func (s CategoryService) CreateCategory(ctx context.Context, category domain.Category) (domain.Category, error) {
 	return s.repo.CreateCategory(ctx, category)
 }
@@ -32,13 +39,23 @@ func (s CategoryService) UpdateCategory(ctx context.Context, category domain.Cat
 	return s.repo.UpdateCategory(ctx, category)
 }
 
-func (s CategoryService) DeleteCategory(ctx context.Context, id int) error {
+// DeleteCategory deletes the category and handles its books by the policy of the deletion,
+// it returns the number of books that belonged to the category
+func (s CategoryService) DeleteCategory(ctx context.Context, id int, deletion domain.CategoryDeletion) (int, error) {
 	if id == 0 {
-		return fmt.Errorf("%w: id", domain.ErrRequired)
+		return 0, fmt.Errorf("%w: id", domain.ErrRequired)
 	}
-	return s.repo.DeleteCategory(ctx, id)
+	if deletion.ReassignTo() == id {
+		return 0, fmt.Errorf("%w: the books can't be reassigned to the deleted category", domain.ErrInvalidDeletePolicy)
+	}
+	return s.repo.DeleteCategory(ctx, id, deletion)
+}
+
+func (s CategoryService) GetCategories(ctx context.Context, page domain.PageRequest) (domain.Page[domain.Category], error) {
+	return s.repo.GetCategories(ctx, page)
 }
 
-func (s CategoryService) GetCategories(ctx context.Context) ([]domain.Category, error) {
-	return s.repo.GetCategories(ctx)
+// GetCategoryTree returns all the categories nested under their parents
+func (s CategoryService) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
+	return s.repo.GetCategoryTree(ctx)
 }
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryService_DeleteCategory_Success(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	service := NewCategoryService(mockRepo)
+	ctx := context.Background()
+	deletion, err := domain.NewCategoryDeletion(domain.NewCategoryDeletionData{Policy: domain.CategoryDeleteReassign, ReassignTo: 2})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		DeleteCategory(ctx, 1, deletion).
+		Return(5, nil).
+		Once()
+
+	// Act
+	affected, err := service.DeleteCategory(ctx, 1, deletion)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 5, affected)
+}
+
+func TestCategoryService_DeleteCategory_ReassignToItself(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	service := NewCategoryService(mockRepo)
+	deletion, err := domain.NewCategoryDeletion(domain.NewCategoryDeletionData{Policy: domain.CategoryDeleteReassign, ReassignTo: 1})
+	require.NoError(t, err)
+
+	// Act
+	affected, err := service.DeleteCategory(context.Background(), 1, deletion)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrInvalidDeletePolicy)
+	assert.Zero(t, affected)
+}
+
+func TestCategoryService_DeleteCategory_NotEmpty(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	service := NewCategoryService(mockRepo)
+	ctx := context.Background()
+	deletion, err := domain.NewCategoryDeletion(domain.NewCategoryDeletionData{})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		DeleteCategory(ctx, 1, deletion).
+		Return(0, domain.ErrCategoryNotEmpty).
+		Once()
+
+	// Act
+	affected, err := service.DeleteCategory(ctx, 1, deletion)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrCategoryNotEmpty)
+	assert.Zero(t, affected)
+}
+
+func TestCategoryService_GetCategoryBySlug_Success(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	service := NewCategoryService(mockRepo)
+	ctx := context.Background()
+	category, err := domain.NewCategory(domain.NewCategoryData{ID: 1, Name: "Science Fiction", BookCount: 3})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetCategoryBySlug(ctx, "science-fiction").
+		Return(category, nil).
+		Once()
+
+	// Act
+	result, err := service.GetCategoryBySlug(ctx, "science-fiction")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ID())
+	assert.Equal(t, 3, result.BookCount())
+}
+
+func TestCategoryService_GetCategoryBySlug_EmptySlug(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	service := NewCategoryService(mockRepo)
+
+	// Act
+	_, err := service.GetCategoryBySlug(context.Background(), "")
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrRequired)
+	mockRepo.AssertNotCalled(t, "GetCategoryBySlug")
+}
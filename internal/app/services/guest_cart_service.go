@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+)
+
+// guestCartTokenBytes is the number of random bytes in a new guest cart token
+const guestCartTokenBytes = 32
+
+// GetGuestCart returns the books in the guest cart, an unknown token has no items
+func (s CartService) GetGuestCart(ctx context.Context, token string) ([]domain.CartItem, error) {
+	err := validateCartToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.cartRepo.GetGuestCartItems(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest cart items: %w", err)
+	}
+
+	return items, nil
+}
+
+// AddGuestBook reserves quantity copies of a book in the guest cart like AddBook does for users.
+// An empty token starts a new guest cart, the token of the cart is returned together with its books.
+func (s CartService) AddGuestBook(ctx context.Context, token string, bookID, quantity int) (string, []domain.CartItem, error) {
+	if bookID <= 0 {
+		return "", nil, fmt.Errorf("%w: book_id", domain.ErrNegative)
+	}
+	if quantity <= 0 {
+		return "", nil, fmt.Errorf("%w: %d", domain.ErrInvalidQuantity, quantity)
+	}
+
+	if token == "" {
+		var err error
+		token, err = newGuestCartToken()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	err := validateCartToken(token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = s.cartRepo.UpdateGuestCart(ctx, token, func(cart *domain.Cart) error {
+		return cart.SetQuantity(bookID, quantity)
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to add book to guest cart: %w", err)
+	}
+
+	items, err := s.GetGuestCart(ctx, token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, items, nil
+}
+
+// RemoveGuestBook releases a book from the guest cart and returns the updated cart
+func (s CartService) RemoveGuestBook(ctx context.Context, token string, bookID int) ([]domain.CartItem, error) {
+	if bookID <= 0 {
+		return nil, fmt.Errorf("%w: book_id", domain.ErrNegative)
+	}
+	err := validateCartToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cartRepo.UpdateGuestCart(ctx, token, func(cart *domain.Cart) error {
+		cart.RemoveBook(bookID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove book from guest cart: %w", err)
+	}
+
+	return s.GetGuestCart(ctx, token)
+}
+
+// ClearGuestCart empties the guest cart and releases every reservation
+func (s CartService) ClearGuestCart(ctx context.Context, token string) error {
+	err := validateCartToken(token)
+	if err != nil {
+		return err
+	}
+
+	err = s.cartRepo.ReleaseGuestCart(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to clear guest cart: %w", err)
+	}
+
+	return nil
+}
+
+// MergeGuestCart moves the books of the guest cart into the cart of a user who has just signed in
+// and returns the IDs of the books that could not be merged because they are out of stock
+func (s CartService) MergeGuestCart(ctx context.Context, userID int, token string) ([]int, error) {
+	err := validateCartToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped, err := s.cartRepo.MergeGuestCart(ctx, token, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge guest cart: %w", err)
+	}
+
+	return dropped, nil
+}
+
+func validateCartToken(token string) error {
+	err := domain.ValidateCartToken(token)
+	if err != nil {
+		return slugerrors.NewBadRequestError(err.Error(), "invalid-cart-token")
+	}
+	return nil
+}
+
+func newGuestCartToken() (string, error) {
+	token := make([]byte, guestCartTokenBytes)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate cart token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartService_AddGuestBook_NewTokenStartsCart(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+	ctx := context.Background()
+
+	var updatedCart domain.Cart
+	mockRepo.EXPECT().
+		UpdateGuestCart(ctx, mock.AnythingOfType("string"), mock.Anything).
+		RunAndReturn(func(_ context.Context, token string, updateFn func(cart *domain.Cart) error) error {
+			cart, err := domain.NewGuestCart(token)
+			require.NoError(t, err)
+			err = updateFn(&cart)
+			updatedCart = cart
+			return err
+		}).
+		Once()
+	mockRepo.EXPECT().
+		GetGuestCartItems(ctx, mock.AnythingOfType("string")).
+		Return([]domain.CartItem{}, nil).
+		Once()
+
+	// Act
+	token, _, err := service.AddGuestBook(ctx, "", 2, 4)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NoError(t, domain.ValidateCartToken(token))
+	assert.Equal(t, token, updatedCart.Token())
+	assert.Equal(t, 4, updatedCart.Quantity(2))
+}
+
+func TestCartService_GetGuestCart_InvalidToken(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+
+	// Act
+	items, err := service.GetGuestCart(context.Background(), "not a token")
+
+	// Assert
+	var slugErr slugerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, "invalid-cart-token", slugErr.Slug())
+	assert.Nil(t, items)
+	mockRepo.AssertNotCalled(t, "GetGuestCartItems", mock.Anything, mock.Anything)
+}
+
+func TestCartService_MergeGuestCart_ReturnsDroppedBooks(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockCartRepository(t)
+	service := NewCartService(mockRepo, nil, nil, 0)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().
+		MergeGuestCart(ctx, "guest-token", 7).
+		Return([]int{3}, nil).
+		Once()
+
+	// Act
+	dropped, err := service.MergeGuestCart(ctx, 7, "guest-token")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, dropped)
+}
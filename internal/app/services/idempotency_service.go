@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+)
+
+type IdempotencyService struct {
+	repo  IdempotencyRepository
+	ttl   time.Duration
+	lease time.Duration
+}
+
+// NewIdempotencyService creates a new idempotency service instance,
+// keys can be replayed for ttl after they were first used. A key whose request didn't finish within lease
+// is taken over by a retry, the request is assumed to have crashed.
+func NewIdempotencyService(repo IdempotencyRepository, ttl, lease time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		repo:  repo,
+		ttl:   ttl,
+		lease: lease,
+	}
+}
+
+// Begin reserves the idempotency key for the request. When the key was already used
+// for the same request the stored key is returned together with true, and its response
+// has to be sent back instead of handling the request again
+func (s IdempotencyService) Begin(ctx context.Context, userID int, key string, request []byte) (domain.IdempotencyKey, bool, error) {
+	hash := sha256.Sum256(request)
+	requestHash := hex.EncodeToString(hash[:])
+
+	newKey, err := domain.NewIdempotencyKey(domain.NewIdempotencyKeyData{
+		Key:         key,
+		UserID:      userID,
+		RequestHash: requestHash,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return domain.IdempotencyKey{}, false, slugerrors.NewBadRequestError(err.Error(), "invalid-idempotency-key")
+	}
+
+	storedKey, created, err := s.repo.AcquireKey(ctx, newKey, s.ttl, s.lease)
+	if err != nil {
+		return domain.IdempotencyKey{}, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+	if created {
+		return storedKey, false, nil
+	}
+
+	if !storedKey.Matches(requestHash) {
+		return domain.IdempotencyKey{}, false, slugerrors.NewBadRequestError("idempotency key was used for a different request", "idempotency-key-reused")
+	}
+	if !storedKey.Completed() {
+		return domain.IdempotencyKey{}, false, slugerrors.NewConflictError("request with this idempotency key is in progress", "idempotency-key-in-progress")
+	}
+
+	return storedKey, true, nil
+}
+
+// Complete stores the response of the request started with Begin
+func (s IdempotencyService) Complete(ctx context.Context, key domain.IdempotencyKey, statusCode int, response []byte) error {
+	key.Complete(statusCode, response, time.Now())
+
+	err := s.repo.CompleteKey(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// Abandon forgets the key of a failed request, so that the client can retry it with the same key
+func (s IdempotencyService) Abandon(ctx context.Context, key domain.IdempotencyKey) error {
+	err := s.repo.DeleteKey(ctx, key.UserID(), key.Key())
+	if err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func hashRequest(request string) string {
+	hash := sha256.Sum256([]byte(request))
+	return hex.EncodeToString(hash[:])
+}
+
+func newStoredKey(t *testing.T, request string, completed bool) domain.IdempotencyKey {
+	data := domain.NewIdempotencyKeyData{
+		Key:         "key-1",
+		UserID:      7,
+		RequestHash: hashRequest(request),
+		CreatedAt:   time.Now(),
+	}
+	if completed {
+		data.StatusCode = 200
+		data.Response = []byte(`{"id":1}`)
+		data.CompletedAt = time.Now()
+	}
+	key, err := domain.NewIdempotencyKey(data)
+	require.NoError(t, err)
+	return key
+}
+
+func TestIdempotencyService_Begin_NewKey(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockIdempotencyRepository(t)
+	service := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().
+		AcquireKey(ctx, mock.MatchedBy(func(key domain.IdempotencyKey) bool {
+			return key.Key() == "key-1" && key.UserID() == 7 && key.Matches(hashRequest("POST /checkout"))
+		}), time.Hour, time.Minute).
+		RunAndReturn(func(_ context.Context, key domain.IdempotencyKey, _, _ time.Duration) (domain.IdempotencyKey, bool, error) {
+			return key, true, nil
+		}).
+		Once()
+
+	// Act
+	key, replay, err := service.Begin(ctx, 7, "key-1", []byte("POST /checkout"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, replay)
+	assert.Equal(t, "key-1", key.Key())
+}
+
+func TestIdempotencyService_Begin_StoredKey(t *testing.T) {
+	testCases := []struct {
+		name           string
+		storedRequest  string
+		completed      bool
+		expectedReplay bool
+		expectedSlug   string
+		expectedType   slugerrors.ErrorType
+	}{
+		{"Replay of a completed request", "POST /checkout", true, true, "", slugerrors.ErrorType{}},
+		{"Different request with the same key", "POST /cart", true, false, "idempotency-key-reused", slugerrors.ErrorTypeBadRequest},
+		{"Request still in progress", "POST /checkout", false, false, "idempotency-key-in-progress", slugerrors.ErrorTypeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockIdempotencyRepository(t)
+			service := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+			ctx := context.Background()
+			storedKey := newStoredKey(t, tc.storedRequest, tc.completed)
+
+			mockRepo.EXPECT().
+				AcquireKey(ctx, mock.Anything, time.Hour, time.Minute).
+				Return(storedKey, false, nil).
+				Once()
+
+			// Act
+			key, replay, err := service.Begin(ctx, 7, "key-1", []byte("POST /checkout"))
+
+			// Assert
+			assert.Equal(t, tc.expectedReplay, replay)
+			if tc.expectedSlug == "" {
+				require.NoError(t, err)
+				assert.Equal(t, []byte(`{"id":1}`), key.Response())
+				return
+			}
+			var slugErr slugerrors.SlugError
+			require.True(t, errors.As(err, &slugErr))
+			assert.Equal(t, tc.expectedSlug, slugErr.Slug())
+			assert.Equal(t, tc.expectedType, slugErr.ErrorType())
+		})
+	}
+}
+
+func TestIdempotencyService_Begin_InvalidKey(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockIdempotencyRepository(t)
+	service := NewIdempotencyService(mockRepo, time.Hour, time.Minute)
+
+	// Act
+	_, _, err := service.Begin(context.Background(), 7, "", []byte("POST /checkout"))
+
+	// Assert
+	var slugErr slugerrors.SlugError
+	require.True(t, errors.As(err, &slugErr))
+	assert.Equal(t, "invalid-idempotency-key", slugErr.Slug())
+	mockRepo.AssertNotCalled(t, "AcquireKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
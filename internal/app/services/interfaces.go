@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"time"
 	"toptal/internal/app/domain"
 )
 
@@ -14,25 +15,92 @@ type UserRepository interface {
 
 type BookRepository interface {
 	GetBook(ctx context.Context, id int) (domain.Book, error)
-	GetBooks(ctx context.Context, categoryIDs []int, limit, offset int) ([]domain.Book, error)
+	GetBooks(ctx context.Context, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error)
+	GetBookFacets(ctx context.Context, filter domain.BookFilter) (domain.BookFacets, error)
 	CreateBook(ctx context.Context, book domain.Book) (domain.Book, error)
 	UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error)
 	DeleteBook(ctx context.Context, id int) error
+	SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error)
 }
 
 type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category domain.Category) (domain.Category, error)
 	GetCategory(ctx context.Context, id int) (domain.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (domain.Category, error)
 	UpdateCategory(ctx context.Context, category domain.Category) (domain.Category, error)
-	DeleteCategory(ctx context.Context, id int) error
-	GetCategories(ctx context.Context) ([]domain.Category, error)
+	DeleteCategory(ctx context.Context, id int, deletion domain.CategoryDeletion) (int, error)
+	GetCategories(ctx context.Context, page domain.PageRequest) (domain.Page[domain.Category], error)
+	GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error)
 }
 
 type CartRepository interface {
 	GetCart(ctx context.Context, userID int) (domain.Cart, error)
+	GetCartItems(ctx context.Context, userID int) ([]domain.CartItem, error)
 	DeleteCart(ctx context.Context, userID int) error
+	ReleaseCart(ctx context.Context, userID int) error
 	UpdateCartAndStocks(ctx context.Context, cart domain.Cart) error
+	UpdateCart(ctx context.Context, userID int, updateFn func(cart *domain.Cart) error) error
 	CheckStocks(ctx context.Context, cart domain.Cart) (bool, error)
+	GetGuestCartItems(ctx context.Context, token string) ([]domain.CartItem, error)
+	UpdateGuestCart(ctx context.Context, token string, updateFn func(cart *domain.Cart) error) error
+	ReleaseGuestCart(ctx context.Context, token string) error
+	MergeGuestCart(ctx context.Context, token string, userID int) ([]int, error)
+}
+
+type OrderRepository interface {
+	CreateOrderFromCart(ctx context.Context, userID int) (domain.Order, error)
+	GetOrder(ctx context.Context, id int) (domain.Order, error)
+	GetOrders(ctx context.Context, userID int, page domain.PageRequest) (domain.Page[domain.Order], error)
+	UpdateOrder(ctx context.Context, id int, updateFn func(order *domain.Order) error) (domain.Order, error)
+}
+
+type WishlistRepository interface {
+	CreateWishlist(ctx context.Context, wishlist domain.Wishlist) (domain.Wishlist, error)
+	GetWishlist(ctx context.Context, id int) (domain.Wishlist, error)
+	GetWishlists(ctx context.Context, userID int) ([]domain.Wishlist, error)
+	UpdateWishlist(ctx context.Context, id int, updateFn func(wishlist *domain.Wishlist) error) (domain.Wishlist, error)
+	DeleteWishlist(ctx context.Context, id int) error
+}
+
+type InventoryRepository interface {
+	RecordMovement(ctx context.Context, movement domain.InventoryMovement) (domain.Book, error)
+	GetInventory(ctx context.Context, bookID int) (domain.Inventory, error)
+	ReconcileStocks(ctx context.Context, fix bool, actorID int) ([]domain.StockReconciliation, error)
+}
+
+type SubscriptionRepository interface {
+	Subscribe(ctx context.Context, subscription domain.BookSubscription) error
+	Unsubscribe(ctx context.Context, userID, bookID int) error
+	GetSubscriptions(ctx context.Context, userID int) ([]domain.BookSubscription, error)
+	ClaimPendingNotifications(ctx context.Context, limit int, claimedBefore time.Time) ([]domain.StockNotification, error)
+	MarkNotificationSent(ctx context.Context, id int, sentAt time.Time) error
+	MarkNotificationFailed(ctx context.Context, id int, reason string) error
+}
+
+// Notifier tells a subscriber that the book they waited for is back in stock.
+type Notifier interface {
+	NotifyBackInStock(ctx context.Context, notification domain.StockNotification) error
+}
+
+type IdempotencyRepository interface {
+	AcquireKey(ctx context.Context, key domain.IdempotencyKey, ttl, lease time.Duration) (domain.IdempotencyKey, bool, error)
+	CompleteKey(ctx context.Context, key domain.IdempotencyKey) error
+	DeleteKey(ctx context.Context, userID int, key string) error
+	DeleteExpiredKeys(ctx context.Context, ttl time.Duration) error
+}
+
+// PaymentProvider charges customers for their orders.
+// Authorize reserves the order total and returns the payment reference, Capture takes the authorized money,
+// Void releases an authorization that won't be captured and Refund returns captured money.
+// VoidOrder releases every uncaptured authorization of the order, an Authorize that timed out may have
+// placed a hold the caller never got the reference of.
+// Refunding a payment that was refunded already succeeds without returning the money twice.
+type PaymentProvider interface {
+	Authorize(ctx context.Context, order domain.Order) (string, error)
+	Capture(ctx context.Context, paymentID string, amount int) error
+	Void(ctx context.Context, paymentID string) error
+	VoidOrder(ctx context.Context, orderID int) error
+	Refund(ctx context.Context, paymentID string, amount int) error
 }
 
 type AuthRepository interface {
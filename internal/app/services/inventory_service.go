@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+)
+
+type InventoryService struct {
+	repo InventoryRepository
+}
+
+// NewInventoryService creates a new inventory service instance
+func NewInventoryService(repo InventoryRepository) *InventoryService {
+	return &InventoryService{
+		repo: repo,
+	}
+}
+
+// Restock adds received copies of a book to its stock, only admins are allowed to do it
+func (s InventoryService) Restock(ctx context.Context, actor domain.User, bookID, quantity int, reason string) (domain.Book, error) {
+	if !actor.Admin() {
+		return domain.Book{}, slugerrors.NewAuthorizationError("only admins can restock books", "not-admin")
+	}
+
+	movement, err := domain.NewInventoryMovement(domain.NewInventoryMovementData{
+		BookID:    bookID,
+		Kind:      domain.MovementRestock,
+		Quantity:  quantity,
+		Reason:    reason,
+		ActorID:   actor.ID(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return s.repo.RecordMovement(ctx, movement)
+}
+
+// GetInventory returns the stock of a book next to its ledger, only admins are allowed to see it
+func (s InventoryService) GetInventory(ctx context.Context, actor domain.User, bookID int) (domain.Inventory, error) {
+	if !actor.Admin() {
+		return domain.Inventory{}, slugerrors.NewAuthorizationError("only admins can see the inventory", "not-admin")
+	}
+
+	return s.repo.GetInventory(ctx, bookID)
+}
+
+// ReconcileStocks compares the stocks with the ones expected from the restocks, reservations and sales
+// and with fix corrects the mismatches, only admins are allowed to do it
+func (s InventoryService) ReconcileStocks(ctx context.Context, actor domain.User, fix bool) ([]domain.StockReconciliation, error) {
+	if !actor.Admin() {
+		return nil, slugerrors.NewAuthorizationError("only admins can reconcile stocks", "not-admin")
+	}
+
+	return s.repo.ReconcileStocks(ctx, fix, actor.ID())
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryService_Restock_RecordsRestockByAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockInventoryRepository(t)
+	service := NewInventoryService(mockRepo)
+	ctx := context.Background()
+	admin, err := domain.NewUserFromToken(domain.NewUserData{ID: 1, Email: "admin@example.com", Admin: true})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		RecordMovement(ctx, mock.MatchedBy(func(movement domain.InventoryMovement) bool {
+			return movement.BookID() == 3 &&
+				movement.Kind() == domain.MovementRestock &&
+				movement.Quantity() == 20 &&
+				movement.Reason() == "new shipment" &&
+				movement.ActorID() == 1
+		})).
+		Return(domain.Book{}, nil).
+		Once()
+
+	// Act
+	_, err = service.Restock(ctx, admin, 3, 20, "new shipment")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestInventoryService_Restock_NotAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockInventoryRepository(t)
+	service := NewInventoryService(mockRepo)
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: 7, Email: "reader@example.com"})
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.Restock(context.Background(), user, 3, 20, "new shipment")
+
+	// Assert
+	var slugError slugerrors.SlugError
+	require.ErrorAs(t, err, &slugError)
+	assert.Equal(t, "not-admin", slugError.Slug())
+}
+
+func TestInventoryService_Restock_NegativeQuantity(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockInventoryRepository(t)
+	service := NewInventoryService(mockRepo)
+	admin, err := domain.NewUserFromToken(domain.NewUserData{ID: 1, Email: "admin@example.com", Admin: true})
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.Restock(context.Background(), admin, 3, -20, "")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidQuantity)
+}
+
+func TestInventoryService_ReconcileStocks_FixesAsAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockInventoryRepository(t)
+	service := NewInventoryService(mockRepo)
+	ctx := context.Background()
+	admin, err := domain.NewUserFromToken(domain.NewUserData{ID: 1, Email: "admin@example.com", Admin: true})
+	require.NoError(t, err)
+	reconciliation, err := domain.NewStockReconciliation(domain.NewStockReconciliationData{BookID: 3, Stock: 5, Restocked: 5, Fixed: true})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		ReconcileStocks(ctx, true, 1).
+		Return([]domain.StockReconciliation{reconciliation}, nil).
+		Once()
+
+	// Act
+	reconciliations, err := service.ReconcileStocks(ctx, admin, true)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []domain.StockReconciliation{reconciliation}, reconciliations)
+}
+
+func TestInventoryService_ReconcileStocks_NotAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockInventoryRepository(t)
+	service := NewInventoryService(mockRepo)
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: 7, Email: "reader@example.com"})
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.ReconcileStocks(context.Background(), user, false)
+
+	// Assert
+	var slugError slugerrors.SlugError
+	require.ErrorAs(t, err, &slugError)
+	assert.Equal(t, "not-admin", slugError.Slug())
+}
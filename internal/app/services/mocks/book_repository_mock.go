@@ -227,28 +227,92 @@ func (_c *MockBookRepository_GetBook_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetBookFacets provides a mock function for the type MockBookRepository
+func (_mock *MockBookRepository) GetBookFacets(ctx context.Context, filter domain.BookFilter) (domain.BookFacets, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBookFacets")
+	}
+
+	var r0 domain.BookFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookFilter) (domain.BookFacets, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookFilter) domain.BookFacets); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.BookFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.BookFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookRepository_GetBookFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBookFacets'
+type MockBookRepository_GetBookFacets_Call struct {
+	*mock.Call
+}
+
+// GetBookFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.BookFilter
+func (_e *MockBookRepository_Expecter) GetBookFacets(ctx interface{}, filter interface{}) *MockBookRepository_GetBookFacets_Call {
+	return &MockBookRepository_GetBookFacets_Call{Call: _e.mock.On("GetBookFacets", ctx, filter)}
+}
+
+func (_c *MockBookRepository_GetBookFacets_Call) Run(run func(ctx context.Context, filter domain.BookFilter)) *MockBookRepository_GetBookFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.BookFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.BookFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookRepository_GetBookFacets_Call) Return(bookFacets domain.BookFacets, err error) *MockBookRepository_GetBookFacets_Call {
+	_c.Call.Return(bookFacets, err)
+	return _c
+}
+
+func (_c *MockBookRepository_GetBookFacets_Call) RunAndReturn(run func(ctx context.Context, filter domain.BookFilter) (domain.BookFacets, error)) *MockBookRepository_GetBookFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBooks provides a mock function for the type MockBookRepository
-func (_mock *MockBookRepository) GetBooks(ctx context.Context, categoryIDs []int, limit int, offset int) ([]domain.Book, error) {
-	ret := _mock.Called(ctx, categoryIDs, limit, offset)
+func (_mock *MockBookRepository) GetBooks(ctx context.Context, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error) {
+	ret := _mock.Called(ctx, filter, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBooks")
 	}
 
-	var r0 []domain.Book
+	var r0 domain.Page[domain.Book]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int, int, int) ([]domain.Book, error)); ok {
-		return returnFunc(ctx, categoryIDs, limit, offset)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookFilter, domain.PageRequest) (domain.Page[domain.Book], error)); ok {
+		return returnFunc(ctx, filter, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int, int, int) []domain.Book); ok {
-		r0 = returnFunc(ctx, categoryIDs, limit, offset)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.BookFilter, domain.PageRequest) domain.Page[domain.Book]); ok {
+		r0 = returnFunc(ctx, filter, page)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Book)
-		}
+		r0 = ret.Get(0).(domain.Page[domain.Book])
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []int, int, int) error); ok {
-		r1 = returnFunc(ctx, categoryIDs, limit, offset)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.BookFilter, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, filter, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -262,47 +326,115 @@ type MockBookRepository_GetBooks_Call struct {
 
 // GetBooks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - categoryIDs []int
+//   - filter domain.BookFilter
+//   - page domain.PageRequest
+func (_e *MockBookRepository_Expecter) GetBooks(ctx interface{}, filter interface{}, page interface{}) *MockBookRepository_GetBooks_Call {
+	return &MockBookRepository_GetBooks_Call{Call: _e.mock.On("GetBooks", ctx, filter, page)}
+}
+
+func (_c *MockBookRepository_GetBooks_Call) Run(run func(ctx context.Context, filter domain.BookFilter, page domain.PageRequest)) *MockBookRepository_GetBooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.BookFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.BookFilter)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookRepository_GetBooks_Call) Return(page1 domain.Page[domain.Book], err error) *MockBookRepository_GetBooks_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockBookRepository_GetBooks_Call) RunAndReturn(run func(ctx context.Context, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error)) *MockBookRepository_GetBooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestBooks provides a mock function for the type MockBookRepository
+func (_mock *MockBookRepository) SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error) {
+	ret := _mock.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestBooks")
+	}
+
+	var r0 []domain.BookSuggestion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.BookSuggestion, error)); ok {
+		return returnFunc(ctx, prefix, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []domain.BookSuggestion); ok {
+		r0 = returnFunc(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BookSuggestion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookRepository_SuggestBooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestBooks'
+type MockBookRepository_SuggestBooks_Call struct {
+	*mock.Call
+}
+
+// SuggestBooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
 //   - limit int
-//   - offset int
-func (_e *MockBookRepository_Expecter) GetBooks(ctx interface{}, categoryIDs interface{}, limit interface{}, offset interface{}) *MockBookRepository_GetBooks_Call {
-	return &MockBookRepository_GetBooks_Call{Call: _e.mock.On("GetBooks", ctx, categoryIDs, limit, offset)}
+func (_e *MockBookRepository_Expecter) SuggestBooks(ctx interface{}, prefix interface{}, limit interface{}) *MockBookRepository_SuggestBooks_Call {
+	return &MockBookRepository_SuggestBooks_Call{Call: _e.mock.On("SuggestBooks", ctx, prefix, limit)}
 }
 
-func (_c *MockBookRepository_GetBooks_Call) Run(run func(ctx context.Context, categoryIDs []int, limit int, offset int)) *MockBookRepository_GetBooks_Call {
+func (_c *MockBookRepository_SuggestBooks_Call) Run(run func(ctx context.Context, prefix string, limit int)) *MockBookRepository_SuggestBooks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []int
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].([]int)
+			arg1 = args[1].(string)
 		}
 		var arg2 int
 		if args[2] != nil {
 			arg2 = args[2].(int)
 		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBookRepository_GetBooks_Call) Return(books []domain.Book, err error) *MockBookRepository_GetBooks_Call {
-	_c.Call.Return(books, err)
+func (_c *MockBookRepository_SuggestBooks_Call) Return(bookSuggestions []domain.BookSuggestion, err error) *MockBookRepository_SuggestBooks_Call {
+	_c.Call.Return(bookSuggestions, err)
 	return _c
 }
 
-func (_c *MockBookRepository_GetBooks_Call) RunAndReturn(run func(ctx context.Context, categoryIDs []int, limit int, offset int) ([]domain.Book, error)) *MockBookRepository_GetBooks_Call {
+func (_c *MockBookRepository_SuggestBooks_Call) RunAndReturn(run func(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error)) *MockBookRepository_SuggestBooks_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"toptal/internal/app/domain"
+)
+
+// notificationBatchSize is the number of notifications sent by one DispatchNotifications call
+const notificationBatchSize = 100
+
+type NotificationService struct {
+	repo        SubscriptionRepository
+	notifier    Notifier
+	sendTimeout time.Duration
+}
+
+// NewNotificationService creates a new back-in-stock notification service instance,
+// sending a notification fails when it takes longer than sendTimeout
+func NewNotificationService(repo SubscriptionRepository, notifier Notifier, sendTimeout time.Duration) *NotificationService {
+	return &NotificationService{
+		repo:        repo,
+		notifier:    notifier,
+		sendTimeout: sendTimeout,
+	}
+}
+
+// Subscribe asks to notify the user once the sold-out book is back in stock
+func (s NotificationService) Subscribe(ctx context.Context, userID, bookID int) (domain.BookSubscription, error) {
+	subscription, err := domain.NewBookSubscription(domain.NewBookSubscriptionData{
+		UserID:    userID,
+		BookID:    bookID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.BookSubscription{}, err
+	}
+
+	err = s.repo.Subscribe(ctx, subscription)
+	if err != nil {
+		return domain.BookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+// Unsubscribe cancels the subscription of the user to the book
+func (s NotificationService) Unsubscribe(ctx context.Context, userID, bookID int) error {
+	return s.repo.Unsubscribe(ctx, userID, bookID)
+}
+
+// GetSubscriptions returns the books the user waits for
+func (s NotificationService) GetSubscriptions(ctx context.Context, userID int) ([]domain.BookSubscription, error) {
+	subscriptions, err := s.repo.GetSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// DispatchNotifications claims a batch of queued back-in-stock notifications, sends them and returns how many were sent.
+// A notification that can't be sent stays queued and is retried by the next call
+// until it runs out of attempts. The claim keeps other dispatchers from sending the batch,
+// it expires once every notification of the batch could have timed out.
+func (s NotificationService) DispatchNotifications(ctx context.Context) (int, error) {
+	claimedBefore := time.Now().Add(-notificationBatchSize * s.sendTimeout)
+	notifications, err := s.repo.ClaimPendingNotifications(ctx, notificationBatchSize, claimedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending notifications: %w", err)
+	}
+
+	var sent int
+	for _, notification := range notifications {
+		err := s.send(ctx, notification)
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+			err = s.repo.MarkNotificationFailed(ctx, notification.ID(), err.Error())
+			if err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		err = s.repo.MarkNotificationSent(ctx, notification.ID(), time.Now())
+		if err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s NotificationService) send(ctx context.Context, notification domain.StockNotification) error {
+	ctx, cancel := context.WithTimeout(ctx, s.sendTimeout)
+	defer cancel()
+
+	return s.notifier.NotifyBackInStock(ctx, notification)
+}
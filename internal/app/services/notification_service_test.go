@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStockNotification(t *testing.T, id int) domain.StockNotification {
+	t.Helper()
+	notification, err := domain.NewStockNotification(domain.NewStockNotificationData{
+		ID:     id,
+		UserID: 7,
+		Email:  "reader@example.com",
+		BookID: 1,
+		Title:  "Valid Title",
+	})
+	require.NoError(t, err)
+	return notification
+}
+
+func TestNotificationService_DispatchNotifications_KeepsFailedNotificationsQueued(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockSubscriptionRepository(t)
+	mockNotifier := mocks.NewMockNotifier(t)
+	service := NewNotificationService(mockRepo, mockNotifier, time.Second)
+	ctx := context.Background()
+	delivered := newTestStockNotification(t, 1)
+	undelivered := newTestStockNotification(t, 2)
+
+	mockRepo.EXPECT().
+		ClaimPendingNotifications(ctx, notificationBatchSize, mock.Anything).
+		Return([]domain.StockNotification{delivered, undelivered}, nil).
+		Once()
+	mockNotifier.EXPECT().
+		NotifyBackInStock(mock.Anything, delivered).
+		Return(nil).
+		Once()
+	mockNotifier.EXPECT().
+		NotifyBackInStock(mock.Anything, undelivered).
+		Return(errors.New("connection refused")).
+		Once()
+	mockRepo.EXPECT().
+		MarkNotificationSent(ctx, 1, mock.Anything).
+		Return(nil).
+		Once()
+	mockRepo.EXPECT().
+		MarkNotificationFailed(ctx, 2, "connection refused").
+		Return(nil).
+		Once()
+
+	// Act
+	sent, err := service.DispatchNotifications(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}
+
+func TestNotificationService_DispatchNotifications_TimesOutSlowSends(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockSubscriptionRepository(t)
+	mockNotifier := mocks.NewMockNotifier(t)
+	sendTimeout := 10 * time.Millisecond
+	service := NewNotificationService(mockRepo, mockNotifier, sendTimeout)
+	ctx := context.Background()
+	notification := newTestStockNotification(t, 1)
+	start := time.Now()
+
+	mockRepo.EXPECT().
+		ClaimPendingNotifications(ctx, notificationBatchSize, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int, claimedBefore time.Time) ([]domain.StockNotification, error) {
+			// a claim expires once the whole batch could have timed out
+			assert.WithinDuration(t, start.Add(-notificationBatchSize*sendTimeout), claimedBefore, time.Second)
+			return []domain.StockNotification{notification}, nil
+		}).
+		Once()
+	mockNotifier.EXPECT().
+		NotifyBackInStock(mock.Anything, notification).
+		RunAndReturn(func(ctx context.Context, _ domain.StockNotification) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}).
+		Once()
+	mockRepo.EXPECT().
+		MarkNotificationFailed(ctx, 1, context.DeadlineExceeded.Error()).
+		Return(nil).
+		Once()
+
+	// Act
+	sent, err := service.DispatchNotifications(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+}
+
+func TestNotificationService_Subscribe_InvalidBookID(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockSubscriptionRepository(t)
+	service := NewNotificationService(mockRepo, nil, time.Second)
+
+	// Act
+	subscription, err := service.Subscribe(context.Background(), 7, 0)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrNegative)
+	assert.Equal(t, domain.BookSubscription{}, subscription)
+}
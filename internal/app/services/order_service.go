@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+)
+
+type OrderService struct {
+	repo     OrderRepository
+	payments PaymentProvider
+}
+
+// NewOrderService creates a new order service instance
+func NewOrderService(repo OrderRepository, payments PaymentProvider) *OrderService {
+	return &OrderService{
+		repo:     repo,
+		payments: payments,
+	}
+}
+
+// GetOrder returns an order if the user is allowed to see it
+func (s OrderService) GetOrder(ctx context.Context, user domain.User, id int) (domain.Order, error) {
+	if id == 0 {
+		return domain.Order{}, fmt.Errorf("%w: id", domain.ErrRequired)
+	}
+
+	order, err := s.repo.GetOrder(ctx, id)
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	// do not reveal that somebody else's order exists
+	if !order.CanBeViewedBy(user) {
+		return domain.Order{}, domain.ErrNotFound
+	}
+
+	return order, nil
+}
+
+// GetOrders returns a page of the user's orders newest first. Admins can list the orders
+// of any user, or of all users when userID is 0
+func (s OrderService) GetOrders(ctx context.Context, user domain.User, userID int, page domain.PageRequest) (domain.Page[domain.Order], error) {
+	if !user.Admin() {
+		userID = user.ID()
+	}
+	return s.repo.GetOrders(ctx, userID, page)
+}
+
+// ChangeOrderStatus moves an order to the next status, only admins are allowed to do it.
+// Cancelled and refunded orders are refunded after the status is saved, repeating the change of an order
+// whose refund failed retries the refund.
+func (s OrderService) ChangeOrderStatus(ctx context.Context, actor domain.User, id int, status domain.OrderStatus) (domain.Order, error) {
+	if id == 0 {
+		return domain.Order{}, fmt.Errorf("%w: id", domain.ErrRequired)
+	}
+	if !actor.Admin() {
+		return domain.Order{}, slugerrors.NewAuthorizationError("only admins can change the order status", "not-admin")
+	}
+
+	order, err := s.repo.UpdateOrder(ctx, id, func(order *domain.Order) error {
+		if refundPending(*order, status) {
+			return nil
+		}
+		return order.ChangeStatus(status, actor.ID(), time.Now())
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	return s.refund(ctx, order)
+}
+
+// CancelOrder cancels an order that has not been shipped yet, puts its books back in stock and refunds it.
+// Cancelling an order whose refund failed again retries the refund.
+func (s OrderService) CancelOrder(ctx context.Context, actor domain.User, id int) (domain.Order, error) {
+	if id == 0 {
+		return domain.Order{}, fmt.Errorf("%w: id", domain.ErrRequired)
+	}
+
+	order, err := s.repo.UpdateOrder(ctx, id, func(order *domain.Order) error {
+		if order.CanBeViewedBy(actor) && refundPending(*order, domain.OrderStatusCancelled) {
+			return nil
+		}
+		return order.Cancel(actor, time.Now())
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	return s.refund(ctx, order)
+}
+
+// refundPending reports whether the order is already in the status but its refund failed
+func refundPending(order domain.Order, status domain.OrderStatus) bool {
+	return order.Status() == status && order.NeedsRefund()
+}
+
+// refund returns the money of a cancelled or refunded order once its status is saved and records the refund.
+// The provider doesn't refund a payment twice, so a refund that isn't recorded can be retried.
+func (s OrderService) refund(ctx context.Context, order domain.Order) (domain.Order, error) {
+	if !order.NeedsRefund() {
+		return order, nil
+	}
+
+	// the status is saved already, the refund has to finish even when the request is gone
+	ctx = context.WithoutCancel(ctx)
+	err := s.payments.Refund(ctx, order.PaymentID(), order.Total())
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("order %d is %s but its refund failed: %w", order.ID(), order.Status(), err)
+	}
+
+	return s.repo.UpdateOrder(ctx, order.ID(), func(order *domain.Order) error {
+		return order.MarkRefunded(time.Now())
+	})
+}
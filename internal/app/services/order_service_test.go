@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/payment"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOrderUser(t *testing.T, id int, admin bool) domain.User {
+	t.Helper()
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: id, Email: "user@example.com", Admin: admin})
+	require.NoError(t, err)
+	return user
+}
+
+func newTestOrder(t *testing.T, userID int, status domain.OrderStatus, paymentID string) domain.Order {
+	t.Helper()
+	order, err := domain.NewOrder(domain.NewOrderData{
+		ID:        5,
+		UserID:    userID,
+		Items:     []domain.NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000, Quantity: 2}},
+		Status:    status,
+		PaymentID: paymentID,
+	})
+	require.NoError(t, err)
+	return order
+}
+
+// expectOrderUpdates applies the update functions of the service to the order like the repository does,
+// every update starts from the order saved by the previous one. It returns the saved order.
+func expectOrderUpdates(t *testing.T, mockRepo *mocks.MockOrderRepository, order domain.Order) *domain.Order {
+	t.Helper()
+	saved := order
+	mockRepo.EXPECT().
+		UpdateOrder(mock.Anything, order.ID(), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int, updateFn func(order *domain.Order) error) (domain.Order, error) {
+			updated := saved
+			err := updateFn(&updated)
+			if err != nil {
+				return domain.Order{}, err
+			}
+			saved = updated
+			return saved, nil
+		})
+	return &saved
+}
+
+// paidTestOrder authorizes and captures the total of an order with the provider and returns the paid order
+func paidTestOrder(t *testing.T, provider *payment.FakeProvider, userID int, status domain.OrderStatus) domain.Order {
+	t.Helper()
+	order := newTestOrder(t, userID, domain.OrderStatusPending, "")
+	paymentID, err := provider.Authorize(context.Background(), order)
+	require.NoError(t, err)
+	require.NoError(t, provider.Capture(context.Background(), paymentID, order.Total()))
+	return newTestOrder(t, userID, status, paymentID)
+}
+
+func TestOrderService_GetOrders_UserIDByRole(t *testing.T) {
+	testCases := []struct {
+		name           string
+		admin          bool
+		userID         int
+		expectedUserID int
+	}{
+		{"Customer lists own orders", false, 0, 7},
+		{"Customer can't list another user's orders", false, 9, 7},
+		{"Admin lists all orders", true, 0, 0},
+		{"Admin lists another user's orders", true, 9, 9},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockOrderRepository(t)
+			service := NewOrderService(mockRepo, nil)
+			ctx := context.Background()
+			pageRequest, err := domain.NewPageRequest(domain.NewPageRequestData{WithTotal: true})
+			require.NoError(t, err)
+			page, err := domain.NewPage(domain.NewPageData[domain.Order]{
+				Items:   []domain.Order{newTestOrder(t, 7, domain.OrderStatusPaid, "pay_1")},
+				Total:   1,
+				Counted: true,
+			})
+			require.NoError(t, err)
+
+			mockRepo.EXPECT().
+				GetOrders(ctx, tc.expectedUserID, pageRequest).
+				Return(page, nil).
+				Once()
+
+			// Act
+			result, err := service.GetOrders(ctx, newTestOrderUser(t, 7, tc.admin), tc.userID, pageRequest)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, page, result)
+		})
+	}
+}
+
+func TestOrderService_GetOrder_Visibility(t *testing.T) {
+	testCases := []struct {
+		name        string
+		userID      int
+		admin       bool
+		expectedErr error
+	}{
+		{"Owner", 7, false, nil},
+		{"Another customer", 8, false, domain.ErrNotFound},
+		{"Admin", 1, true, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockOrderRepository(t)
+			service := NewOrderService(mockRepo, nil)
+			ctx := context.Background()
+			order := newTestOrder(t, 7, domain.OrderStatusPaid, "pay_1")
+
+			mockRepo.EXPECT().
+				GetOrder(ctx, order.ID()).
+				Return(order, nil).
+				Once()
+
+			// Act
+			result, err := service.GetOrder(ctx, newTestOrderUser(t, tc.userID, tc.admin), order.ID())
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				assert.Equal(t, domain.Order{}, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, order.ID(), result.ID())
+		})
+	}
+}
+
+func TestOrderService_ChangeOrderStatus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		from           domain.OrderStatus
+		to             domain.OrderStatus
+		expectedErr    error
+		expectedRefund bool
+	}{
+		{"Ship a paid order", domain.OrderStatusPaid, domain.OrderStatusShipped, nil, false},
+		{"Refund a delivered order", domain.OrderStatusDelivered, domain.OrderStatusRefunded, nil, true},
+		{"Cancel a paid order", domain.OrderStatusPaid, domain.OrderStatusCancelled, nil, true},
+		{"Deliver a pending order", domain.OrderStatusPending, domain.OrderStatusDelivered, domain.ErrInvalidStatusTransition, false},
+		{"Unknown status", domain.OrderStatusPaid, domain.OrderStatus("lost"), domain.ErrInvalidOrderStatus, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockOrderRepository(t)
+			provider := payment.NewFakeProvider()
+			service := NewOrderService(mockRepo, provider)
+			order := paidTestOrder(t, provider, 7, tc.from)
+			saved := expectOrderUpdates(t, mockRepo, order)
+
+			// Act
+			result, err := service.ChangeOrderStatus(context.Background(), newTestOrderUser(t, 1, true), order.ID(), tc.to)
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.to, result.Status())
+				require.NotEmpty(t, result.History())
+				assert.Equal(t, 1, result.History()[len(result.History())-1].ActorID())
+				assert.Equal(t, tc.expectedRefund, !result.RefundedAt().IsZero())
+			}
+			assert.Equal(t, tc.expectedRefund, provider.Refunded(order.PaymentID()))
+			assert.False(t, saved.NeedsRefund(), "the refund is recorded")
+		})
+	}
+}
+
+func TestOrderService_ChangeOrderStatus_NotAdmin(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockOrderRepository(t)
+	service := NewOrderService(mockRepo, nil)
+
+	// Act
+	result, err := service.ChangeOrderStatus(context.Background(), newTestOrderUser(t, 7, false), 5, domain.OrderStatusShipped)
+
+	// Assert
+	var slugError slugerrors.SlugError
+	require.ErrorAs(t, err, &slugError)
+	assert.Equal(t, "not-admin", slugError.Slug())
+	assert.Equal(t, domain.Order{}, result)
+}
+
+func TestOrderService_CancelOrder(t *testing.T) {
+	testCases := []struct {
+		name           string
+		actorID        int
+		admin          bool
+		from           domain.OrderStatus
+		expectedErr    error
+		expectedRefund bool
+	}{
+		{"Owner cancels a pending order", 7, false, domain.OrderStatusPending, nil, false},
+		{"Owner cancels a paid order", 7, false, domain.OrderStatusPaid, nil, true},
+		{"Admin cancels a paid order", 1, true, domain.OrderStatusPaid, nil, true},
+		{"Another customer", 8, false, domain.OrderStatusPaid, domain.ErrNotFound, false},
+		{"Shipped order", 7, false, domain.OrderStatusShipped, domain.ErrInvalidStatusTransition, false},
+		{"Cancelled order whose refund failed", 7, false, domain.OrderStatusCancelled, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockRepo := mocks.NewMockOrderRepository(t)
+			provider := payment.NewFakeProvider()
+			service := NewOrderService(mockRepo, provider)
+			order := newTestOrder(t, 7, tc.from, "")
+			if tc.from != domain.OrderStatusPending {
+				order = paidTestOrder(t, provider, 7, tc.from)
+			}
+			saved := expectOrderUpdates(t, mockRepo, order)
+
+			// Act
+			result, err := service.CancelOrder(context.Background(), newTestOrderUser(t, tc.actorID, tc.admin), order.ID())
+
+			// Assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, domain.OrderStatusCancelled, result.Status())
+			}
+			assert.Equal(t, tc.expectedRefund, provider.Refunded(order.PaymentID()))
+			assert.False(t, saved.NeedsRefund(), "the refund is recorded")
+		})
+	}
+}
+
+func TestOrderService_CancelOrder_RefundedOrder(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockOrderRepository(t)
+	provider := payment.NewFakeProvider()
+	service := NewOrderService(mockRepo, provider)
+	order, err := domain.NewOrder(domain.NewOrderData{
+		ID:         5,
+		UserID:     7,
+		Items:      []domain.NewOrderItemData{{BookID: 1, Title: "Valid Title", Price: 1000}},
+		Status:     domain.OrderStatusCancelled,
+		PaymentID:  "pay_1",
+		RefundedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	expectOrderUpdates(t, mockRepo, order)
+
+	// Act
+	_, err = service.CancelOrder(context.Background(), newTestOrderUser(t, 7, false), order.ID())
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+}
+
+func TestOrderService_CancelOrder_FailedRefundIsRetried(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockOrderRepository(t)
+	provider := payment.NewFakeProvider()
+	service := NewOrderService(mockRepo, provider)
+	actor := newTestOrderUser(t, 7, false)
+	// the provider doesn't know the payment, so the refund fails
+	saved := expectOrderUpdates(t, mockRepo, newTestOrder(t, 7, domain.OrderStatusPaid, "pay_unknown"))
+
+	// Act
+	_, err := service.CancelOrder(context.Background(), actor, 5)
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrPaymentNotFound)
+	assert.Equal(t, domain.OrderStatusCancelled, saved.Status(), "the status change is kept")
+	assert.True(t, saved.NeedsRefund())
+
+	// Act
+	paid := paidTestOrder(t, provider, 7, domain.OrderStatusCancelled)
+	*saved = paid
+	result, err := service.CancelOrder(context.Background(), actor, 5)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, provider.Refunded(paid.PaymentID()))
+	assert.False(t, result.RefundedAt().IsZero())
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"toptal/internal/app/common/slugerrors"
+	"toptal/internal/app/domain"
+)
+
+type WishlistService struct {
+	repo     WishlistRepository
+	cartRepo CartRepository
+}
+
+// NewWishlistService creates a new wishlist service instance, books are moved to the cart through cartRepo
+func NewWishlistService(repo WishlistRepository, cartRepo CartRepository) *WishlistService {
+	return &WishlistService{
+		repo:     repo,
+		cartRepo: cartRepo,
+	}
+}
+
+// GetWishlists returns the wishlists of the user ordered by name
+func (s WishlistService) GetWishlists(ctx context.Context, user domain.User) ([]domain.Wishlist, error) {
+	wishlists, err := s.repo.GetWishlists(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlists: %w", err)
+	}
+
+	return wishlists, nil
+}
+
+// GetWishlist returns a wishlist of the user
+func (s WishlistService) GetWishlist(ctx context.Context, user domain.User, id int) (domain.Wishlist, error) {
+	if id <= 0 {
+		return domain.Wishlist{}, domain.ErrNotFound
+	}
+
+	wishlist, err := s.repo.GetWishlist(ctx, id)
+	if err != nil {
+		return domain.Wishlist{}, err
+	}
+
+	// do not reveal that somebody else's wishlist exists
+	if !wishlist.CanBeViewedBy(user) {
+		return domain.Wishlist{}, domain.ErrNotFound
+	}
+
+	return wishlist, nil
+}
+
+// CreateWishlist creates an empty wishlist for the user
+func (s WishlistService) CreateWishlist(ctx context.Context, user domain.User, name string) (domain.Wishlist, error) {
+	now := time.Now()
+	wishlist, err := domain.NewWishlist(domain.NewWishlistData{
+		UserID:    user.ID(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return domain.Wishlist{}, err
+	}
+
+	return s.repo.CreateWishlist(ctx, wishlist)
+}
+
+// RenameWishlist gives a wishlist of the user a new name
+func (s WishlistService) RenameWishlist(ctx context.Context, user domain.User, id int, name string) (domain.Wishlist, error) {
+	return s.updateWishlist(ctx, user, id, func(wishlist *domain.Wishlist) error {
+		return wishlist.Rename(name, time.Now())
+	})
+}
+
+// DeleteWishlist deletes a wishlist of the user
+func (s WishlistService) DeleteWishlist(ctx context.Context, user domain.User, id int) error {
+	_, err := s.GetWishlist(ctx, user, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteWishlist(ctx, id)
+}
+
+// AddBook puts a book in a wishlist of the user without reserving it
+func (s WishlistService) AddBook(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, error) {
+	return s.updateWishlist(ctx, user, id, func(wishlist *domain.Wishlist) error {
+		return wishlist.AddBook(bookID, time.Now())
+	})
+}
+
+// RemoveBook takes a book out of a wishlist of the user
+func (s WishlistService) RemoveBook(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, error) {
+	return s.updateWishlist(ctx, user, id, func(wishlist *domain.Wishlist) error {
+		wishlist.RemoveBook(bookID, time.Now())
+		return nil
+	})
+}
+
+// MoveToCart reserves one copy of a book from the wishlist in the user's cart the same way
+// adding it to the cart does, and takes the book out of the wishlist once it is reserved.
+// A book that is already in the cart keeps its quantity.
+func (s WishlistService) MoveToCart(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, []domain.CartItem, error) {
+	wishlist, err := s.GetWishlist(ctx, user, id)
+	if err != nil {
+		return domain.Wishlist{}, nil, err
+	}
+	if !wishlist.HasBook(bookID) {
+		return domain.Wishlist{}, nil, slugerrors.NewNotFoundError("book is not in the wishlist", "wishlist-item-not-found")
+	}
+
+	err = s.cartRepo.UpdateCart(ctx, user.ID(), func(cart *domain.Cart) error {
+		cart.AddBook(bookID)
+		return nil
+	})
+	if err != nil {
+		return domain.Wishlist{}, nil, fmt.Errorf("failed to add book to cart: %w", err)
+	}
+
+	wishlist, err = s.RemoveBook(ctx, user, id, bookID)
+	if err != nil {
+		return domain.Wishlist{}, nil, err
+	}
+
+	items, err := s.cartRepo.GetCartItems(ctx, user.ID())
+	if err != nil {
+		return domain.Wishlist{}, nil, fmt.Errorf("failed to get cart items: %w", err)
+	}
+
+	return wishlist, items, nil
+}
+
+func (s WishlistService) updateWishlist(ctx context.Context, user domain.User, id int, updateFn func(wishlist *domain.Wishlist) error) (domain.Wishlist, error) {
+	if id <= 0 {
+		return domain.Wishlist{}, domain.ErrNotFound
+	}
+
+	return s.repo.UpdateWishlist(ctx, id, func(wishlist *domain.Wishlist) error {
+		if !wishlist.CanBeViewedBy(user) {
+			return domain.ErrNotFound
+		}
+		return updateFn(wishlist)
+	})
+}
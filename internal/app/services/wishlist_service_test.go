@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWishlist(t *testing.T, userID int, bookIDs ...int) domain.Wishlist {
+	t.Helper()
+	items := make([]domain.NewWishlistItemData, 0, len(bookIDs))
+	for _, bookID := range bookIDs {
+		items = append(items, domain.NewWishlistItemData{BookID: bookID, Title: "Valid Title", Price: 1000, Stock: 1})
+	}
+	wishlist, err := domain.NewWishlist(domain.NewWishlistData{ID: 3, UserID: userID, Name: "Later", Items: items})
+	require.NoError(t, err)
+	return wishlist
+}
+
+func TestWishlistService_GetWishlist_AnotherUser_ReturnsNotFound(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWishlistRepository(t)
+	service := NewWishlistService(mockRepo, nil)
+	ctx := context.Background()
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: 8, Email: "other@example.com"})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetWishlist(ctx, 3).
+		Return(newTestWishlist(t, 7, 1), nil).
+		Once()
+
+	// Act
+	wishlist, err := service.GetWishlist(ctx, user, 3)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Equal(t, domain.Wishlist{}, wishlist)
+}
+
+func TestWishlistService_MoveToCart_ReservesAndRemovesBook(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWishlistRepository(t)
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	service := NewWishlistService(mockRepo, mockCartRepo)
+	ctx := context.Background()
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: 7, Email: "owner@example.com"})
+	require.NoError(t, err)
+
+	mockRepo.EXPECT().
+		GetWishlist(ctx, 3).
+		Return(newTestWishlist(t, 7, 1, 2), nil).
+		Once()
+
+	var updatedCart domain.Cart
+	mockCartRepo.EXPECT().
+		UpdateCart(ctx, 7, mock.Anything).
+		RunAndReturn(func(_ context.Context, userID int, updateFn func(cart *domain.Cart) error) error {
+			cart, err := domain.NewEmptyCart(userID)
+			require.NoError(t, err)
+			err = updateFn(&cart)
+			updatedCart = cart
+			return err
+		}).
+		Once()
+
+	mockRepo.EXPECT().
+		UpdateWishlist(ctx, 3, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ int, updateFn func(wishlist *domain.Wishlist) error) (domain.Wishlist, error) {
+			wishlist := newTestWishlist(t, 7, 1, 2)
+			err := updateFn(&wishlist)
+			return wishlist, err
+		}).
+		Once()
+	mockCartRepo.EXPECT().
+		GetCartItems(ctx, 7).
+		Return([]domain.CartItem{}, nil).
+		Once()
+
+	// Act
+	wishlist, _, err := service.MoveToCart(ctx, user, 3, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, updatedCart.BookIDs())
+	assert.Equal(t, []int{1}, wishlist.BookIDs())
+}
+
+func TestWishlistService_MoveToCart_OutOfStock_KeepsBook(t *testing.T) {
+	// Arrange
+	mockRepo := mocks.NewMockWishlistRepository(t)
+	mockCartRepo := mocks.NewMockCartRepository(t)
+	service := NewWishlistService(mockRepo, mockCartRepo)
+	ctx := context.Background()
+	user, err := domain.NewUserFromToken(domain.NewUserData{ID: 7, Email: "owner@example.com"})
+	require.NoError(t, err)
+	outOfStock := errors.New("some books are out of stock")
+
+	mockRepo.EXPECT().
+		GetWishlist(ctx, 3).
+		Return(newTestWishlist(t, 7, 2), nil).
+		Once()
+	mockCartRepo.EXPECT().
+		UpdateCart(ctx, 7, mock.Anything).
+		Return(outOfStock).
+		Once()
+
+	// Act
+	_, items, err := service.MoveToCart(ctx, user, 3, 2)
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, outOfStock)
+	assert.Nil(t, items)
+	mockRepo.AssertNotCalled(t, "UpdateWishlist", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"log"
 	"strings"
 	"toptal/internal/app/common/auth"
 	"toptal/internal/app/transport/interfaces"
@@ -15,12 +16,14 @@ type AuthServer struct {
 	authv1.UnimplementedAuthServiceServer
 	userService interfaces.UserService
 	authService interfaces.AuthService
+	cartService interfaces.CartService
 }
 
-func NewAuthServer(userService interfaces.UserService, authService interfaces.AuthService) *AuthServer {
+func NewAuthServer(userService interfaces.UserService, authService interfaces.AuthService, cartService interfaces.CartService) *AuthServer {
 	return &AuthServer{
 		userService: userService,
 		authService: authService,
+		cartService: cartService,
 	}
 }
 
@@ -73,5 +76,20 @@ func (s *AuthServer) SignIn(ctx context.Context, req *authv1.SignInRequest) (*au
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
-	return &authv1.SignInResponse{Token: token}, nil
+	response := &authv1.SignInResponse{Token: token}
+
+	// the guest cart moves to the signed in user
+	if req.CartToken != "" {
+		dropped, err := s.cartService.MergeGuestCart(ctx, user.ID(), req.CartToken)
+		if err != nil {
+			// the user is signed in already, a stale or broken guest cart doesn't fail the sign in
+			log.Printf("failed to merge guest cart of user %d: %v", user.ID(), err)
+			response.CartMergeFailed = true
+		}
+		for _, bookID := range dropped {
+			response.DroppedBookIds = append(response.DroppedBookIds, int64(bookID))
+		}
+	}
+
+	return response, nil
 }
@@ -3,6 +3,8 @@ package grpcserver
 import (
 	"context"
 	"errors"
+	"toptal/internal/app/common/auth"
+	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/transport/interfaces"
 	bookv1 "toptal/proto/v1/book"
@@ -23,33 +25,123 @@ func NewBookServer(bookService interfaces.BookService) *BookServer {
 }
 
 func (s *BookServer) ListBooks(ctx context.Context, req *bookv1.ListBooksRequest) (*bookv1.ListBooksResponse, error) {
+	// anonymous visitors list the books as the zero user
+	user, _ := auth.GetUserFromGRPCMetadata(ctx)
+
 	categoryIds := make([]int, len(req.CategoryId))
 	for i, categoryID := range req.CategoryId {
 		categoryIds[i] = int(categoryID)
 	}
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{
+		CategoryIDs:          categoryIds,
+		IncludeSubcategories: req.IncludeSubcategories,
+		Query:                req.Q,
+		Author:               req.Author,
+		AuthorContains:       req.AuthorContains,
+		YearMin:              int(req.YearMin),
+		YearMax:              int(req.YearMax),
+		PriceMin:             int(req.PriceMin),
+		PriceMax:             int(req.PriceMax),
+		IncludeSoldOut:       req.IncludeSoldOut,
+		Sort:                 domain.BookSort(req.Sort),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	page := int(req.Page)
-	var limit, offset int
-	if page > 0 {
-		limit = 10
-		offset = (page - 1) * limit
+	pageRequest, err := domain.NewPageRequest(domain.NewPageRequestData{
+		Size:      int(req.PageSize),
+		Number:    int(req.Page),
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	books, err := s.bookService.GetBooks(ctx, categoryIds, limit, offset)
+	page, err := s.bookService.GetBooks(ctx, user, filter, pageRequest)
 	if err != nil {
+		var slugError slugerrors.SlugError
+		if errors.As(err, &slugError) {
+			return nil, toSlugError(err)
+		}
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get books: %v", err)
 	}
 
-	response := make([]*bookv1.CreateBookResponse, 0, len(books))
-	for _, book := range books {
+	response := make([]*bookv1.CreateBookResponse, 0, len(page.Items()))
+	for _, book := range page.Items() {
 		response = append(response, toGRPCBookResponse(book))
 	}
 
 	return &bookv1.ListBooksResponse{
-		Books: response,
+		Books:      response,
+		NextCursor: page.NextCursor(),
+		TotalCount: toGRPCTotalCount(page.Total()),
 	}, nil
 }
 
+func (s *BookServer) SuggestBooks(ctx context.Context, req *bookv1.SuggestBooksRequest) (*bookv1.SuggestBooksResponse, error) {
+	suggestions, err := s.bookService.SuggestBooks(ctx, req.Prefix, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSuggestPrefix) || errors.Is(err, domain.ErrInvalidLimit) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, toSlugError(err)
+	}
+
+	response := make([]*bookv1.BookSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		response = append(response, &bookv1.BookSuggestion{
+			Text:  suggestion.Text(),
+			Kind:  string(suggestion.Kind()),
+			Score: suggestion.Score(),
+		})
+	}
+
+	return &bookv1.SuggestBooksResponse{
+		Suggestions: response,
+	}, nil
+}
+
+func (s *BookServer) GetBookFacets(ctx context.Context, req *bookv1.GetBookFacetsRequest) (*bookv1.GetBookFacetsResponse, error) {
+	// anonymous visitors count the books as the zero user
+	user, _ := auth.GetUserFromGRPCMetadata(ctx)
+
+	categoryIds := make([]int, len(req.CategoryId))
+	for i, categoryID := range req.CategoryId {
+		categoryIds[i] = int(categoryID)
+	}
+	filter, err := domain.NewBookFilter(domain.NewBookFilterData{
+		CategoryIDs:          categoryIds,
+		IncludeSubcategories: req.IncludeSubcategories,
+		Query:                req.Q,
+		Author:               req.Author,
+		AuthorContains:       req.AuthorContains,
+		YearMin:              int(req.YearMin),
+		YearMax:              int(req.YearMax),
+		PriceMin:             int(req.PriceMin),
+		PriceMax:             int(req.PriceMax),
+		IncludeSoldOut:       req.IncludeSoldOut,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	facets, err := s.bookService.GetBookFacets(ctx, user, filter)
+	if err != nil {
+		var slugError slugerrors.SlugError
+		if errors.As(err, &slugError) {
+			return nil, toSlugError(err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get book facets: %v", err)
+	}
+
+	return toGRPCBookFacets(facets), nil
+}
+
 func (s *BookServer) GetBook(ctx context.Context, req *bookv1.GetBookRequest) (*bookv1.GetBookResponse, error) {
 	if req.Id <= 0 {
 		//return convertDomainError(err) TODO: add this
@@ -100,13 +192,14 @@ func (s *BookServer) UpdateBook(ctx context.Context, req *bookv1.UpdateBookReque
 	}
 
 	domainBook, err := domain.NewBook(domain.NewBookData{
-		ID:         int(req.Id),
-		Title:      req.Book.Title,
-		Year:       int(req.Book.Year),
-		Author:     req.Book.Author,
-		Price:      int(req.Book.Price),
-		Stock:      int(req.Book.Stock),
-		CategoryID: int(req.Book.CategoryId),
+		ID:          int(req.Id),
+		Title:       req.Book.Title,
+		Year:        int(req.Book.Year),
+		Author:      req.Book.Author,
+		Price:       int(req.Book.Price),
+		Stock:       int(req.Book.Stock),
+		CategoryID:  int(req.Book.CategoryId),
+		CategoryIDs: toDomainIDs(req.Book.CategoryIds),
 	})
 	if err != nil {
 		return nil, toSlugError(err)
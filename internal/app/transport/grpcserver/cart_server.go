@@ -25,6 +25,25 @@ func NewCartServer(cartService interfaces.CartService, userService interfaces.Us
 	}
 }
 
+func (s *CartServer) GetCart(ctx context.Context, req *cartv1.GetCartRequest) (*cartv1.GetCartResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	items, err := s.cartService.GetCart(ctx, user.ID())
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GetCartResponse{
+		UserId: int64(user.ID()),
+		Cart:   cart,
+		Items:  itemsData,
+	}, nil
+}
+
 func (s *CartServer) UpdateCart(ctx context.Context, req *cartv1.UpdateCartRequest) (*cartv1.UpdateCartResponse, error) {
 	user, err := auth.GetUserFromGRPCMetadata(ctx)
 	if err != nil {
@@ -46,6 +65,12 @@ func (s *CartServer) UpdateCart(ctx context.Context, req *cartv1.UpdateCartReque
 		if errors.Is(err, domain.ErrNil) {
 			return nil, status.Error(codes.InvalidArgument, "missing book_ids")
 		}
+		if errors.Is(err, domain.ErrInvalidBookIDs) {
+			return nil, status.Error(codes.InvalidArgument, "invalid book_ids")
+		}
+		if errors.Is(err, domain.ErrInvalidQuantity) {
+			return nil, status.Error(codes.InvalidArgument, "invalid quantity")
+		}
 		if errors.Is(err, domain.ErrInvalidUserID) {
 			return nil, status.Error(codes.InvalidArgument, "invalid user_id")
 		}
@@ -53,14 +78,84 @@ func (s *CartServer) UpdateCart(ctx context.Context, req *cartv1.UpdateCartReque
 	}
 
 	// Update the cart via the service
-	updatedCart, err := s.cartService.UpdateCartAndStocks(ctx, domainCart)
+	items, err := s.cartService.UpdateCartAndStocks(ctx, domainCart)
 	if err != nil {
 		return nil, toSlugError(err)
 	}
 
+	cart, itemsData := toGRPCCartItems(items)
 	return &cartv1.UpdateCartResponse{
-		UserId: int64(updatedCart.UserID()),
-		Cart:   toGRPCCartData(updatedCart),
+		UserId: int64(user.ID()),
+		Cart:   cart,
+		Items:  itemsData,
+	}, nil
+}
+
+func (s *CartServer) AddCartItem(ctx context.Context, req *cartv1.AddCartItemRequest) (*cartv1.GetCartResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	quantity := int(req.Quantity)
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	items, err := s.cartService.AddBook(ctx, user.ID(), int(req.BookId), quantity)
+	if err != nil {
+		if errors.Is(err, domain.ErrNegative) {
+			return nil, status.Error(codes.InvalidArgument, "invalid book_id")
+		}
+		if errors.Is(err, domain.ErrInvalidQuantity) {
+			return nil, status.Error(codes.InvalidArgument, "invalid quantity")
+		}
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GetCartResponse{
+		UserId: int64(user.ID()),
+		Cart:   cart,
+		Items:  itemsData,
+	}, nil
+}
+
+func (s *CartServer) RemoveCartItem(ctx context.Context, req *cartv1.RemoveCartItemRequest) (*cartv1.GetCartResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	items, err := s.cartService.RemoveBook(ctx, user.ID(), int(req.BookId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNegative) {
+			return nil, status.Error(codes.InvalidArgument, "invalid book_id")
+		}
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GetCartResponse{
+		UserId: int64(user.ID()),
+		Cart:   cart,
+		Items:  itemsData,
+	}, nil
+}
+
+func (s *CartServer) ClearCart(ctx context.Context, req *cartv1.ClearCartRequest) (*cartv1.ClearCartResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	err = s.cartService.ClearCart(ctx, user.ID())
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	return &cartv1.ClearCartResponse{
+		Success: true,
 	}, nil
 }
 
@@ -72,12 +167,13 @@ func (s *CartServer) Checkout(ctx context.Context, req *cartv1.CheckoutRequest)
 	}
 
 	// Place the order via the service
-	err = s.cartService.Checkout(ctx, user.ID())
+	order, err := s.cartService.Checkout(ctx, user.ID())
 	if err != nil {
 		return nil, toSlugError(err)
 	}
 
 	return &cartv1.CheckoutResponse{
-		Success: true,
+		Id:    int64(order.ID()),
+		Order: toGRPCOrderData(order),
 	}, nil
 }
@@ -23,18 +23,43 @@ func NewCategoryServer(categoryService interfaces.CategoryService) *CategoryServ
 }
 
 func (s *CategoryServer) ListCategories(ctx context.Context, req *categoryv1.ListCategoriesRequest) (*categoryv1.ListCategoriesResponse, error) {
-	categories, err := s.categoryService.GetCategories(ctx)
+	pageRequest, err := domain.NewPageRequest(domain.NewPageRequestData{
+		Size:      int(req.PageSize),
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
+	})
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.categoryService.GetCategories(ctx, pageRequest)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get categories: %v", err)
 	}
 
-	response := make([]*categoryv1.CreateCategoryResponse, 0, len(categories))
-	for _, category := range categories {
+	response := make([]*categoryv1.CreateCategoryResponse, 0, len(page.Items()))
+	for _, category := range page.Items() {
 		response = append(response, toGRPCCategoryResponse(category))
 	}
 
 	return &categoryv1.ListCategoriesResponse{
 		Categories: response,
+		NextCursor: page.NextCursor(),
+		TotalCount: toGRPCTotalCount(page.Total()),
+	}, nil
+}
+
+func (s *CategoryServer) GetCategoryTree(ctx context.Context, _ *categoryv1.GetCategoryTreeRequest) (*categoryv1.GetCategoryTreeResponse, error) {
+	tree, err := s.categoryService.GetCategoryTree(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get category tree: %v", err)
+	}
+
+	return &categoryv1.GetCategoryTreeResponse{
+		Categories: toGRPCCategoryTree(tree),
 	}, nil
 }
 
@@ -57,15 +82,31 @@ func (s *CategoryServer) GetCategory(ctx context.Context, req *categoryv1.GetCat
 	}, nil
 }
 
+func (s *CategoryServer) GetCategoryBySlug(ctx context.Context, req *categoryv1.GetCategoryBySlugRequest) (*categoryv1.GetCategoryResponse, error) {
+	if req.Slug == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "slug is required")
+	}
+
+	category, err := s.categoryService.GetCategoryBySlug(ctx, req.Slug)
+	if err != nil {
+		return nil, toGRPCCategoryError(err)
+	}
+
+	return &categoryv1.GetCategoryResponse{
+		Id:       int64(category.ID()),
+		Category: toGRPCCategoryData(category),
+	}, nil
+}
+
 func (s *CategoryServer) CreateCategory(ctx context.Context, req *categoryv1.CreateCategoryRequest) (*categoryv1.CreateCategoryResponse, error) {
 	domainCategory, err := toDomainCategory(req.Category)
 	if err != nil {
-		return nil, toSlugError(err)
+		return nil, toGRPCCategoryError(err)
 	}
 
 	category, err := s.categoryService.CreateCategory(ctx, domainCategory)
 	if err != nil {
-		return nil, toSlugError(err)
+		return nil, toGRPCCategoryError(err)
 	}
 
 	return toGRPCCategoryResponse(category), nil
@@ -77,7 +118,7 @@ func (s *CategoryServer) UpdateCategory(ctx context.Context, req *categoryv1.Upd
 	}
 
 	// Verify the category exists
-	_, err := s.categoryService.GetCategory(ctx, int(req.Id))
+	existingCategory, err := s.categoryService.GetCategory(ctx, int(req.Id))
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "category not found: %v", err)
@@ -85,17 +126,37 @@ func (s *CategoryServer) UpdateCategory(ctx context.Context, req *categoryv1.Upd
 		return nil, toSlugError(err)
 	}
 
+	parentID := existingCategory.ParentID()
+	if req.Category.ParentId != nil {
+		parentID = int(req.Category.GetParentId())
+	}
+	slug := existingCategory.Slug()
+	if req.Category.Slug != nil {
+		slug = req.Category.GetSlug()
+	}
+	description := existingCategory.Description()
+	if req.Category.Description != nil {
+		description = req.Category.GetDescription()
+	}
+	displayOrder := existingCategory.DisplayOrder()
+	if req.Category.DisplayOrder != nil {
+		displayOrder = int(req.Category.GetDisplayOrder())
+	}
 	domainCategory, err := domain.NewCategory(domain.NewCategoryData{
-		ID:   int(req.Id),
-		Name: req.Category.Name,
+		ID:           int(req.Id),
+		Name:         req.Category.Name,
+		ParentID:     parentID,
+		Slug:         slug,
+		Description:  description,
+		DisplayOrder: displayOrder,
 	})
 	if err != nil {
-		return nil, toSlugError(err)
+		return nil, toGRPCCategoryError(err)
 	}
 
 	category, err := s.categoryService.UpdateCategory(ctx, domainCategory)
 	if err != nil {
-		return nil, toSlugError(err)
+		return nil, toGRPCCategoryError(err)
 	}
 
 	return &categoryv1.UpdateCategoryResponse{
@@ -118,12 +179,22 @@ func (s *CategoryServer) DeleteCategory(ctx context.Context, req *categoryv1.Del
 		return nil, toSlugError(err)
 	}
 
-	err = s.categoryService.DeleteCategory(ctx, int(req.Id))
+	deletion, err := domain.NewCategoryDeletion(domain.NewCategoryDeletionData{
+		Policy:     domain.CategoryDeletePolicy(req.Policy),
+		ReassignTo: int(req.ReassignTo),
+	})
 	if err != nil {
-		return nil, toSlugError(err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	affected, err := s.categoryService.DeleteCategory(ctx, int(req.Id), deletion)
+	if err != nil {
+		return nil, toGRPCCategoryError(err)
 	}
 
 	return &categoryv1.DeleteCategoryResponse{
-		Success: true,
+		Success:       true,
+		Policy:        string(deletion.Policy()),
+		AffectedBooks: int64(affected),
 	}, nil
 }
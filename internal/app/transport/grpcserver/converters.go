@@ -2,14 +2,19 @@ package grpcserver
 
 import (
 	"errors"
+	"fmt"
 	"toptal/internal/app/common/slugerrors"
 	"toptal/internal/app/domain"
 	bookv1 "toptal/proto/v1/book"
 	cartv1 "toptal/proto/v1/cart"
 	categoryv1 "toptal/proto/v1/category"
+	orderv1 "toptal/proto/v1/order"
+	subscriptionv1 "toptal/proto/v1/subscription"
+	wishlistv1 "toptal/proto/v1/wishlist"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func toGRPCBookResponse(book domain.Book) *bookv1.CreateBookResponse {
@@ -21,26 +26,75 @@ func toGRPCBookResponse(book domain.Book) *bookv1.CreateBookResponse {
 
 func toGRPCBookData(book domain.Book) *bookv1.BookData {
 	return &bookv1.BookData{
-		Title:      book.Title(),
-		Year:       int32(book.Year()),
-		Author:     book.Author(),
-		Price:      int32(book.Price()),
-		Stock:      int32(book.Stock()),
-		CategoryId: int32(book.CategoryID()),
+		Title:       book.Title(),
+		Year:        int32(book.Year()),
+		Author:      book.Author(),
+		Price:       int32(book.Price()),
+		Stock:       int32(book.Stock()),
+		CategoryId:  int32(book.CategoryID()),
+		CategoryIds: toGRPCIDs(book.CategoryIDs()),
+		Archived:    book.Archived(),
 	}
 }
 
 func toDomainBook(bookRequest *bookv1.BookData) (domain.Book, error) {
 	return domain.NewBook(domain.NewBookData{
-		Title:      bookRequest.Title,
-		Year:       int(bookRequest.Year),
-		Author:     bookRequest.Author,
-		Price:      int(bookRequest.Price),
-		Stock:      int(bookRequest.Stock),
-		CategoryID: int(bookRequest.CategoryId),
+		Title:       bookRequest.Title,
+		Year:        int(bookRequest.Year),
+		Author:      bookRequest.Author,
+		Price:       int(bookRequest.Price),
+		Stock:       int(bookRequest.Stock),
+		CategoryID:  int(bookRequest.CategoryId),
+		CategoryIDs: toDomainIDs(bookRequest.CategoryIds),
 	})
 }
 
+func toGRPCIDs(ids []int) []int32 {
+	grpcIDs := make([]int32, len(ids))
+	for i, id := range ids {
+		grpcIDs[i] = int32(id)
+	}
+	return grpcIDs
+}
+
+func toDomainIDs(ids []int32) []int {
+	domainIDs := make([]int, len(ids))
+	for i, id := range ids {
+		domainIDs[i] = int(id)
+	}
+	return domainIDs
+}
+
+func toGRPCBookFacets(facets domain.BookFacets) *bookv1.GetBookFacetsResponse {
+	response := &bookv1.GetBookFacetsResponse{
+		Categories: make([]*bookv1.CategoryFacet, 0, len(facets.Categories())),
+		Decades:    make([]*bookv1.DecadeFacet, 0, len(facets.Decades())),
+		Prices:     make([]*bookv1.PriceFacet, 0, len(facets.Prices())),
+	}
+	for _, category := range facets.Categories() {
+		response.Categories = append(response.Categories, &bookv1.CategoryFacet{
+			CategoryId: int32(category.CategoryID()),
+			Name:       category.Name(),
+			Count:      int64(category.Count()),
+		})
+	}
+	for _, decade := range facets.Decades() {
+		response.Decades = append(response.Decades, &bookv1.DecadeFacet{
+			Decade: int32(decade.Decade()),
+			Count:  int64(decade.Count()),
+		})
+	}
+	for _, price := range facets.Prices() {
+		response.Prices = append(response.Prices, &bookv1.PriceFacet{
+			MinPrice: int32(price.MinPrice()),
+			MaxPrice: int32(price.MaxPrice()),
+			Count:    int64(price.Count()),
+		})
+	}
+
+	return response
+}
+
 func toGRPCCategoryResponse(category domain.Category) *categoryv1.CreateCategoryResponse {
 	return &categoryv1.CreateCategoryResponse{
 		Id:       int64(category.ID()),
@@ -49,27 +103,79 @@ func toGRPCCategoryResponse(category domain.Category) *categoryv1.CreateCategory
 }
 
 func toGRPCCategoryData(category domain.Category) *categoryv1.CategoryData {
-	return &categoryv1.CategoryData{
-		Name: category.Name(),
+	slug, description, displayOrder := category.Slug(), category.Description(), int32(category.DisplayOrder())
+	data := &categoryv1.CategoryData{
+		Name:         category.Name(),
+		Slug:         &slug,
+		Description:  &description,
+		DisplayOrder: &displayOrder,
+		BookCount:    int64(category.BookCount()),
+	}
+	if category.ParentID() != 0 {
+		parentID := int32(category.ParentID())
+		data.ParentId = &parentID
+	}
+
+	return data
+}
+
+func toGRPCCategoryTree(nodes []domain.CategoryNode) []*categoryv1.CategoryNode {
+	response := make([]*categoryv1.CategoryNode, 0, len(nodes))
+	for _, node := range nodes {
+		response = append(response, &categoryv1.CategoryNode{
+			Id:       int64(node.Category().ID()),
+			Category: toGRPCCategoryData(node.Category()),
+			Children: toGRPCCategoryTree(node.Children()),
+		})
 	}
+
+	return response
 }
 
 func toDomainCategory(categoryRequest *categoryv1.CategoryData) (domain.Category, error) {
 	return domain.NewCategory(domain.NewCategoryData{
-		Name: categoryRequest.Name,
+		Name:         categoryRequest.Name,
+		ParentID:     int(categoryRequest.GetParentId()),
+		Slug:         categoryRequest.GetSlug(),
+		Description:  categoryRequest.GetDescription(),
+		DisplayOrder: int(categoryRequest.GetDisplayOrder()),
 	})
 }
 
-// Cart converters
-func toGRPCCartData(cart domain.Cart) *cartv1.CartData {
-	bookIDs := make([]int64, len(cart.BookIDs()))
-	for i, id := range cart.BookIDs() {
-		bookIDs[i] = int64(id)
+func toGRPCCategoryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Errorf(codes.NotFound, "category not found: %v", err)
+	case errors.Is(err, domain.ErrCategoryCycle), errors.Is(err, domain.ErrParentCategoryNotFound), errors.Is(err, domain.ErrNegative),
+		errors.Is(err, domain.ErrInvalidDeletePolicy), errors.Is(err, domain.ErrReassignTargetNotFound),
+		errors.Is(err, domain.ErrInvalidCategorySlug), errors.Is(err, domain.ErrInvalidCategoryDescription):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrCategorySlugTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrCategoryNotEmpty):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return toSlugError(err)
 	}
+}
 
-	return &cartv1.CartData{
-		BookIds: bookIDs,
+// Cart converters
+func toGRPCCartItems(items []domain.CartItem) (*cartv1.CartData, []*cartv1.CartItemData) {
+	bookIDs := make([]int64, 0, len(items))
+	itemsData := make([]*cartv1.CartItemData, 0, len(items))
+	for _, item := range items {
+		bookIDs = append(bookIDs, int64(item.BookID()))
+		itemsData = append(itemsData, &cartv1.CartItemData{
+			BookId:     int64(item.BookID()),
+			Title:      item.Title(),
+			Price:      int32(item.Price()),
+			Quantity:   int32(item.Quantity()),
+			ExpiresAt:  timestamppb.New(item.ExpiresAt()),
+			ReservedAt: timestamppb.New(item.ReservedAt()),
+		})
 	}
+
+	return &cartv1.CartData{BookIds: bookIDs}, itemsData
 }
 
 func toDomainCartFromGRPC(userID int, cartData *cartv1.CartData) (domain.Cart, error) {
@@ -78,12 +184,117 @@ func toDomainCartFromGRPC(userID int, cartData *cartv1.CartData) (domain.Cart, e
 		bookIDs[i] = int(id)
 	}
 
+	var quantities map[int]int
+	if len(cartData.Items) > 0 {
+		quantities = make(map[int]int, len(cartData.Items))
+		for _, item := range cartData.Items {
+			if _, exists := quantities[int(item.BookId)]; exists {
+				return domain.Cart{}, fmt.Errorf("%w: book %d is listed twice", domain.ErrInvalidBookIDs, item.BookId)
+			}
+			quantities[int(item.BookId)] = int(item.Quantity)
+		}
+	}
+
 	return domain.NewCart(domain.NewCartData{
-		UserID:  userID,
-		BookIDs: bookIDs,
+		UserID:     userID,
+		BookIDs:    bookIDs,
+		Quantities: quantities,
 	})
 }
 
+// Order converters
+func toGRPCOrderData(order domain.Order) *orderv1.OrderData {
+	items := make([]*orderv1.OrderItemData, 0, len(order.Items()))
+	for _, item := range order.Items() {
+		items = append(items, &orderv1.OrderItemData{
+			BookId:   int64(item.BookID()),
+			Title:    item.Title(),
+			Price:    int32(item.Price()),
+			Quantity: int32(item.Quantity()),
+		})
+	}
+
+	history := make([]*orderv1.OrderTransitionData, 0, len(order.History()))
+	for _, transition := range order.History() {
+		history = append(history, &orderv1.OrderTransitionData{
+			From:      string(transition.From()),
+			To:        string(transition.To()),
+			ActorId:   int64(transition.ActorID()),
+			CreatedAt: timestamppb.New(transition.CreatedAt()),
+		})
+	}
+
+	data := &orderv1.OrderData{
+		UserId:    int64(order.UserID()),
+		Items:     items,
+		Total:     int64(order.Total()),
+		Status:    string(order.Status()),
+		CreatedAt: timestamppb.New(order.CreatedAt()),
+		UpdatedAt: timestamppb.New(order.UpdatedAt()),
+		History:   history,
+	}
+	if !order.RefundedAt().IsZero() {
+		data.RefundedAt = timestamppb.New(order.RefundedAt())
+	}
+
+	return data
+}
+
+func toGRPCOrderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Errorf(codes.NotFound, "order not found: %v", err)
+	case errors.Is(err, domain.ErrInvalidOrderStatus), errors.Is(err, domain.ErrInvalidStatusTransition),
+		errors.Is(err, domain.ErrInvalidPageRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return toSlugError(err)
+	}
+}
+
+// Wishlist converters
+func toGRPCWishlistResponse(wishlist domain.Wishlist) *wishlistv1.WishlistResponse {
+	items := make([]*wishlistv1.WishlistItemData, 0, len(wishlist.Items()))
+	for _, item := range wishlist.Items() {
+		items = append(items, &wishlistv1.WishlistItemData{
+			BookId:  int64(item.BookID()),
+			Title:   item.Title(),
+			Price:   int32(item.Price()),
+			InStock: item.InStock(),
+			AddedAt: timestamppb.New(item.AddedAt()),
+		})
+	}
+
+	return &wishlistv1.WishlistResponse{
+		Id: int64(wishlist.ID()),
+		Wishlist: &wishlistv1.WishlistData{
+			Name:      wishlist.Name(),
+			Items:     items,
+			CreatedAt: timestamppb.New(wishlist.CreatedAt()),
+			UpdatedAt: timestamppb.New(wishlist.UpdatedAt()),
+		},
+	}
+}
+
+func toGRPCWishlistError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Errorf(codes.NotFound, "wishlist not found: %v", err)
+	case errors.Is(err, domain.ErrRequired), errors.Is(err, domain.ErrInvalidWishlistName), errors.Is(err, domain.ErrNegative):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return toSlugError(err)
+	}
+}
+
+// Subscription converters
+func toGRPCSubscriptionData(subscription domain.BookSubscription) *subscriptionv1.SubscriptionData {
+	return &subscriptionv1.SubscriptionData{
+		BookId:    int64(subscription.BookID()),
+		CreatedAt: timestamppb.New(subscription.CreatedAt()),
+	}
+}
+
 // Error converters
 func toSlugError(err error) error {
 	var slugError slugerrors.SlugError
@@ -98,6 +309,10 @@ func toSlugError(err error) error {
 		return status.Error(codes.InvalidArgument, slugError.Error())
 	case slugerrors.ErrorTypeNotFound:
 		return status.Error(codes.NotFound, slugError.Error())
+	case slugerrors.ErrorTypeTimeout:
+		return status.Error(codes.DeadlineExceeded, slugError.Error())
+	case slugerrors.ErrorTypeConflict:
+		return status.Error(codes.Aborted, slugError.Error())
 	default:
 		return status.Error(codes.Internal, slugError.Error())
 	}
@@ -117,3 +332,12 @@ func convertDomainError(err error) error {
 		return status.Errorf(codes.Internal, "internal error: %v", err)
 	}
 }
+
+// toGRPCTotalCount sets the optional total count of a listing when it was counted
+func toGRPCTotalCount(total int, counted bool) *int64 {
+	if !counted {
+		return nil
+	}
+	count := int64(total)
+	return &count
+}
@@ -279,7 +279,8 @@ func TestCreateBook_ArchitecturalFindings_ThroughGateway(t *testing.T) {
 
 		wrapped := map[string]interface{}{"book": invalidRequest}
 		requestBody, _ := json.Marshal(wrapped)
-		resp, _ := http.Post(gatewayServer.URL+"/v1/book", "application/json", bytes.NewReader(requestBody))
+		resp, err := http.Post(gatewayServer.URL+"/v1/book", "application/json", bytes.NewReader(requestBody))
+		require.NoError(t, err)
 		defer resp.Body.Close()
 
 		var gatewayError map[string]interface{}
@@ -0,0 +1,96 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/interfaces"
+	cartv1 "toptal/proto/v1/cart"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GuestCartServer struct {
+	cartv1.UnimplementedGuestCartServiceServer
+	cartService interfaces.CartService
+}
+
+func NewGuestCartServer(cartService interfaces.CartService) *GuestCartServer {
+	return &GuestCartServer{
+		cartService: cartService,
+	}
+}
+
+func (s *GuestCartServer) GetGuestCart(ctx context.Context, req *cartv1.GetGuestCartRequest) (*cartv1.GuestCartResponse, error) {
+	// a visitor without a token has an empty cart
+	if req.CartToken == "" {
+		cart, itemsData := toGRPCCartItems(nil)
+		return &cartv1.GuestCartResponse{Cart: cart, Items: itemsData}, nil
+	}
+
+	items, err := s.cartService.GetGuestCart(ctx, req.CartToken)
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GuestCartResponse{
+		CartToken: req.CartToken,
+		Cart:      cart,
+		Items:     itemsData,
+	}, nil
+}
+
+func (s *GuestCartServer) AddGuestCartItem(ctx context.Context, req *cartv1.AddGuestCartItemRequest) (*cartv1.GuestCartResponse, error) {
+	quantity := int(req.Quantity)
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	token, items, err := s.cartService.AddGuestBook(ctx, req.CartToken, int(req.BookId), quantity)
+	if err != nil {
+		if errors.Is(err, domain.ErrNegative) {
+			return nil, status.Error(codes.InvalidArgument, "invalid book_id")
+		}
+		if errors.Is(err, domain.ErrInvalidQuantity) {
+			return nil, status.Error(codes.InvalidArgument, "invalid quantity")
+		}
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GuestCartResponse{
+		CartToken: token,
+		Cart:      cart,
+		Items:     itemsData,
+	}, nil
+}
+
+func (s *GuestCartServer) RemoveGuestCartItem(ctx context.Context, req *cartv1.RemoveGuestCartItemRequest) (*cartv1.GuestCartResponse, error) {
+	items, err := s.cartService.RemoveGuestBook(ctx, req.CartToken, int(req.BookId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNegative) {
+			return nil, status.Error(codes.InvalidArgument, "invalid book_id")
+		}
+		return nil, toSlugError(err)
+	}
+
+	cart, itemsData := toGRPCCartItems(items)
+	return &cartv1.GuestCartResponse{
+		CartToken: req.CartToken,
+		Cart:      cart,
+		Items:     itemsData,
+	}, nil
+}
+
+func (s *GuestCartServer) ClearGuestCart(ctx context.Context, req *cartv1.ClearGuestCartRequest) (*cartv1.ClearCartResponse, error) {
+	err := s.cartService.ClearGuestCart(ctx, req.CartToken)
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	return &cartv1.ClearCartResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"toptal/internal/app/common/auth"
+	cartv1 "toptal/proto/v1/cart"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IdempotencyKeyMetadata     = "idempotency-key"
+	IdempotentReplayedMetadata = "idempotent-replayed"
+)
+
+// idempotentMethods are the RPCs that accept the idempotency-key metadata
+var idempotentMethods = map[string]bool{
+	cartv1.CartService_UpdateCart_FullMethodName:     true,
+	cartv1.CartService_AddCartItem_FullMethodName:    true,
+	cartv1.CartService_RemoveCartItem_FullMethodName: true,
+	cartv1.CartService_ClearCart_FullMethodName:      true,
+	cartv1.CartService_Checkout_FullMethodName:       true,
+}
+
+// idempotencyInterceptor makes a call sent with the idempotency-key metadata safe to retry:
+// a retry of a successful call gets the stored response and is not applied again.
+func (s *GrpcServer) idempotencyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !idempotentMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(IdempotencyKeyMetadata)
+	if len(keys) == 0 || keys[0] == "" {
+		return handler(ctx, req)
+	}
+
+	// unauthenticated calls are rejected by the handler
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return handler(ctx, req)
+	}
+
+	message, ok := req.(proto.Message)
+	if !ok {
+		return nil, status.Error(codes.Internal, "request is not a proto message")
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	request := append([]byte(info.FullMethod+"\n"), body...)
+
+	storedKey, replay, err := s.idempotencyService.Begin(ctx, user.ID(), keys[0], request)
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+	if replay {
+		resp, err := unmarshalIdempotentResponse(storedKey.Response())
+		if err != nil {
+			return nil, toSlugError(err)
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedMetadata, "true"))
+		return resp, nil
+	}
+
+	resp, err := handler(ctx, req)
+
+	// the call is finished, so the key is saved even if the client went away
+	saveCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		abandonErr := s.idempotencyService.Abandon(saveCtx, storedKey)
+		if abandonErr != nil {
+			log.Printf("failed to delete idempotency key: %v", abandonErr)
+		}
+		return nil, err
+	}
+
+	response, marshalErr := marshalIdempotentResponse(resp)
+	if marshalErr == nil {
+		marshalErr = s.idempotencyService.Complete(saveCtx, storedKey, int(codes.OK), response)
+	}
+	if marshalErr != nil {
+		log.Printf("failed to save idempotency key: %v", marshalErr)
+	}
+
+	return resp, nil
+}
+
+// marshalIdempotentResponse stores the response together with its type,
+// so that it can be restored without knowing which RPC it belongs to
+func marshalIdempotentResponse(resp any) ([]byte, error) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response %T is not a proto message", resp)
+	}
+	wrapped, err := anypb.New(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap response: %w", err)
+	}
+	return proto.Marshal(wrapped)
+}
+
+func unmarshalIdempotentResponse(response []byte) (proto.Message, error) {
+	var wrapped anypb.Any
+	err := proto.Unmarshal(response, &wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored response: %w", err)
+	}
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,126 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"toptal/internal/app/common/auth"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/interfaces"
+	inventoryv1 "toptal/proto/v1/inventory"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InventoryServer struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+	inventoryService interfaces.InventoryService
+}
+
+func NewInventoryServer(inventoryService interfaces.InventoryService) *InventoryServer {
+	return &InventoryServer{
+		inventoryService: inventoryService,
+	}
+}
+
+func (s *InventoryServer) RestockBook(ctx context.Context, req *inventoryv1.RestockBookRequest) (*inventoryv1.RestockBookResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "book_id is required and must be greater than 0")
+	}
+
+	book, err := s.inventoryService.Restock(ctx, user, int(req.BookId), int(req.Quantity), req.Reason)
+	if err != nil {
+		return nil, toGRPCInventoryError(err)
+	}
+
+	return &inventoryv1.RestockBookResponse{
+		BookId: int64(book.ID()),
+		Stock:  int32(book.Stock()),
+	}, nil
+}
+
+func (s *InventoryServer) GetBookInventory(ctx context.Context, req *inventoryv1.GetBookInventoryRequest) (*inventoryv1.GetBookInventoryResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "book_id is required and must be greater than 0")
+	}
+
+	inventory, err := s.inventoryService.GetInventory(ctx, user, int(req.BookId))
+	if err != nil {
+		return nil, toGRPCInventoryError(err)
+	}
+
+	movements := make([]*inventoryv1.InventoryMovementData, 0, len(inventory.Movements()))
+	for _, movement := range inventory.Movements() {
+		movements = append(movements, &inventoryv1.InventoryMovementData{
+			Id:        int64(movement.ID()),
+			Kind:      string(movement.Kind()),
+			Quantity:  int32(movement.Quantity()),
+			Reason:    movement.Reason(),
+			ActorId:   int64(movement.ActorID()),
+			CreatedAt: timestamppb.New(movement.CreatedAt()),
+		})
+	}
+
+	return &inventoryv1.GetBookInventoryResponse{
+		BookId:      int64(inventory.BookID()),
+		Stock:       int32(inventory.Stock()),
+		LedgerStock: int32(inventory.LedgerStock()),
+		Consistent:  inventory.Consistent(),
+		Movements:   movements,
+	}, nil
+}
+
+func (s *InventoryServer) ReconcileStocks(ctx context.Context, req *inventoryv1.ReconcileStocksRequest) (*inventoryv1.ReconcileStocksResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	reconciliations, err := s.inventoryService.ReconcileStocks(ctx, user, req.Fix)
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	report := auth.ToResponseStockReconciliation(reconciliations)
+	mismatches := make([]*inventoryv1.StockReconciliationData, 0, len(report.Mismatches))
+	for _, mismatch := range report.Mismatches {
+		mismatches = append(mismatches, &inventoryv1.StockReconciliationData{
+			BookId:     int64(mismatch.BookID),
+			Title:      mismatch.Title,
+			Stock:      int32(mismatch.Stock),
+			Expected:   int32(mismatch.Expected),
+			Difference: int32(mismatch.Difference),
+			Restocked:  int32(mismatch.Restocked),
+			Reserved:   int32(mismatch.Reserved),
+			Sold:       int32(mismatch.Sold),
+			Fixed:      mismatch.Fixed,
+		})
+	}
+
+	return &inventoryv1.ReconcileStocksResponse{
+		Checked:    int32(report.Checked),
+		Mismatches: mismatches,
+	}, nil
+}
+
+func toGRPCInventoryError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "book not found")
+	case errors.Is(err, domain.ErrInvalidQuantity), errors.Is(err, domain.ErrInvalidMovementReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return toSlugError(err)
+	}
+}
@@ -0,0 +1,126 @@
+package grpcserver
+
+import (
+	"context"
+	"toptal/internal/app/common/auth"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/interfaces"
+	orderv1 "toptal/proto/v1/order"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrderServer struct {
+	orderv1.UnimplementedOrderServiceServer
+	orderService interfaces.OrderService
+}
+
+func NewOrderServer(orderService interfaces.OrderService) *OrderServer {
+	return &OrderServer{
+		orderService: orderService,
+	}
+}
+
+func (s *OrderServer) ListOrders(ctx context.Context, req *orderv1.ListOrdersRequest) (*orderv1.ListOrdersResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	// the first page when page is not set
+	if req.Page < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page must not be negative")
+	}
+	pageRequest, err := domain.NewPageRequest(domain.NewPageRequestData{
+		Size:      int(req.PageSize),
+		Number:    int(req.Page),
+		Cursor:    req.Cursor,
+		WithTotal: req.WithTotal,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.orderService.GetOrders(ctx, user, int(req.UserId), pageRequest)
+	if err != nil {
+		return nil, toGRPCOrderError(err)
+	}
+
+	response := make([]*orderv1.GetOrderResponse, 0, len(page.Items()))
+	for _, order := range page.Items() {
+		response = append(response, &orderv1.GetOrderResponse{
+			Id:    int64(order.ID()),
+			Order: toGRPCOrderData(order),
+		})
+	}
+
+	return &orderv1.ListOrdersResponse{
+		Orders:     response,
+		NextCursor: page.NextCursor(),
+		TotalCount: toGRPCTotalCount(page.Total()),
+	}, nil
+}
+
+func (s *OrderServer) GetOrder(ctx context.Context, req *orderv1.GetOrderRequest) (*orderv1.GetOrderResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	order, err := s.orderService.GetOrder(ctx, user, int(req.Id))
+	if err != nil {
+		return nil, toGRPCOrderError(err)
+	}
+
+	return &orderv1.GetOrderResponse{
+		Id:    int64(order.ID()),
+		Order: toGRPCOrderData(order),
+	}, nil
+}
+
+func (s *OrderServer) UpdateOrderStatus(ctx context.Context, req *orderv1.UpdateOrderStatusRequest) (*orderv1.UpdateOrderStatusResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	order, err := s.orderService.ChangeOrderStatus(ctx, user, int(req.Id), domain.OrderStatus(req.Status))
+	if err != nil {
+		return nil, toGRPCOrderError(err)
+	}
+
+	return &orderv1.UpdateOrderStatusResponse{
+		Id:    int64(order.ID()),
+		Order: toGRPCOrderData(order),
+	}, nil
+}
+
+func (s *OrderServer) CancelOrder(ctx context.Context, req *orderv1.CancelOrderRequest) (*orderv1.CancelOrderResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	order, err := s.orderService.CancelOrder(ctx, user, int(req.Id))
+	if err != nil {
+		return nil, toGRPCOrderError(err)
+	}
+
+	return &orderv1.CancelOrderResponse{
+		Id:    int64(order.ID()),
+		Order: toGRPCOrderData(order),
+	}, nil
+}
@@ -8,18 +8,27 @@ import (
 	"toptal/proto/v1/book"
 	cartv1 "toptal/proto/v1/cart"
 	categoryv1 "toptal/proto/v1/category"
+	inventoryv1 "toptal/proto/v1/inventory"
+	orderv1 "toptal/proto/v1/order"
+	subscriptionv1 "toptal/proto/v1/subscription"
+	wishlistv1 "toptal/proto/v1/wishlist"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 type GrpcServer struct {
-	userService     interfaces.UserService
-	authService     interfaces.AuthService
-	bookService     interfaces.BookService
-	cartService     interfaces.CartService
-	categoryService interfaces.CategoryService
-	server          *grpc.Server
+	userService         interfaces.UserService
+	authService         interfaces.AuthService
+	bookService         interfaces.BookService
+	cartService         interfaces.CartService
+	categoryService     interfaces.CategoryService
+	orderService        interfaces.OrderService
+	idempotencyService  interfaces.IdempotencyService
+	wishlistService     interfaces.WishlistService
+	notificationService interfaces.NotificationService
+	inventoryService    interfaces.InventoryService
+	server              *grpc.Server
 }
 
 func NewGrpcServer(userService interfaces.UserService,
@@ -27,14 +36,23 @@ func NewGrpcServer(userService interfaces.UserService,
 	bookService interfaces.BookService,
 	cartService interfaces.CartService,
 	categoryService interfaces.CategoryService,
-
+	orderService interfaces.OrderService,
+	idempotencyService interfaces.IdempotencyService,
+	wishlistService interfaces.WishlistService,
+	notificationService interfaces.NotificationService,
+	inventoryService interfaces.InventoryService,
 ) *GrpcServer {
 	return &GrpcServer{
-		userService:     userService,
-		authService:     authService,
-		bookService:     bookService,
-		cartService:     cartService,
-		categoryService: categoryService,
+		userService:         userService,
+		authService:         authService,
+		bookService:         bookService,
+		cartService:         cartService,
+		categoryService:     categoryService,
+		orderService:        orderService,
+		idempotencyService:  idempotencyService,
+		wishlistService:     wishlistService,
+		notificationService: notificationService,
+		inventoryService:    inventoryService,
 	}
 }
 
@@ -44,7 +62,7 @@ func (s *GrpcServer) Start(grpcAddr string) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	s.server = grpc.NewServer()
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(s.idempotencyInterceptor))
 
 	// Register services
 	s.registerServices(s.server)
@@ -57,14 +75,24 @@ func (s *GrpcServer) Start(grpcAddr string) error {
 
 func (s *GrpcServer) registerServices(server *grpc.Server) {
 	// Register AuthService
-	authServer := NewAuthServer(s.userService, s.authService)
+	authServer := NewAuthServer(s.userService, s.authService, s.cartService)
 	bookServer := NewBookServer(s.bookService)
 	categoryServer := NewCategoryServer(s.categoryService)
 	cartServer := NewCartServer(s.cartService, s.userService)
+	guestCartServer := NewGuestCartServer(s.cartService)
+	orderServer := NewOrderServer(s.orderService)
+	wishlistServer := NewWishlistServer(s.wishlistService)
+	subscriptionServer := NewSubscriptionServer(s.notificationService)
+	inventoryServer := NewInventoryServer(s.inventoryService)
 	authv1.RegisterAuthServiceServer(server, authServer)
 	bookv1.RegisterBookServiceServer(server, bookServer)
 	categoryv1.RegisterCategoryServiceServer(server, categoryServer)
 	cartv1.RegisterCartServiceServer(server, cartServer)
+	cartv1.RegisterGuestCartServiceServer(server, guestCartServer)
+	orderv1.RegisterOrderServiceServer(server, orderServer)
+	wishlistv1.RegisterWishlistServiceServer(server, wishlistServer)
+	subscriptionv1.RegisterSubscriptionServiceServer(server, subscriptionServer)
+	inventoryv1.RegisterInventoryServiceServer(server, inventoryServer)
 }
 
 func (s *GrpcServer) Stop() {
@@ -0,0 +1,84 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"toptal/internal/app/common/auth"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/interfaces"
+	subscriptionv1 "toptal/proto/v1/subscription"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubscriptionServer struct {
+	subscriptionv1.UnimplementedSubscriptionServiceServer
+	notificationService interfaces.NotificationService
+}
+
+func NewSubscriptionServer(notificationService interfaces.NotificationService) *SubscriptionServer {
+	return &SubscriptionServer{
+		notificationService: notificationService,
+	}
+}
+
+func (s *SubscriptionServer) ListSubscriptions(ctx context.Context, _ *subscriptionv1.ListSubscriptionsRequest) (*subscriptionv1.ListSubscriptionsResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	subscriptions, err := s.notificationService.GetSubscriptions(ctx, user.ID())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get subscriptions: %v", err)
+	}
+
+	response := make([]*subscriptionv1.SubscriptionData, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, toGRPCSubscriptionData(subscription))
+	}
+
+	return &subscriptionv1.ListSubscriptionsResponse{
+		Subscriptions: response,
+	}, nil
+}
+
+func (s *SubscriptionServer) Subscribe(ctx context.Context, req *subscriptionv1.SubscribeRequest) (*subscriptionv1.SubscribeResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "book_id is required and must be greater than 0")
+	}
+
+	subscription, err := s.notificationService.Subscribe(ctx, user.ID(), int(req.BookId))
+	if err != nil {
+		return nil, toSlugError(err)
+	}
+
+	return &subscriptionv1.SubscribeResponse{
+		Subscription: toGRPCSubscriptionData(subscription),
+	}, nil
+}
+
+func (s *SubscriptionServer) Unsubscribe(ctx context.Context, req *subscriptionv1.UnsubscribeRequest) (*subscriptionv1.UnsubscribeResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	err = s.notificationService.Unsubscribe(ctx, user.ID(), int(req.BookId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "subscription not found")
+		}
+		return nil, toSlugError(err)
+	}
+
+	return &subscriptionv1.UnsubscribeResponse{
+		Success: true,
+	}, nil
+}
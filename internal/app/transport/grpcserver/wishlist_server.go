@@ -0,0 +1,173 @@
+package grpcserver
+
+import (
+	"context"
+	"toptal/internal/app/common/auth"
+	"toptal/internal/app/transport/interfaces"
+	wishlistv1 "toptal/proto/v1/wishlist"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WishlistServer struct {
+	wishlistv1.UnimplementedWishlistServiceServer
+	wishlistService interfaces.WishlistService
+}
+
+func NewWishlistServer(wishlistService interfaces.WishlistService) *WishlistServer {
+	return &WishlistServer{
+		wishlistService: wishlistService,
+	}
+}
+
+func (s *WishlistServer) ListWishlists(ctx context.Context, _ *wishlistv1.ListWishlistsRequest) (*wishlistv1.ListWishlistsResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	wishlists, err := s.wishlistService.GetWishlists(ctx, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get wishlists: %v", err)
+	}
+
+	response := make([]*wishlistv1.WishlistResponse, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		response = append(response, toGRPCWishlistResponse(wishlist))
+	}
+
+	return &wishlistv1.ListWishlistsResponse{
+		Wishlists: response,
+	}, nil
+}
+
+func (s *WishlistServer) CreateWishlist(ctx context.Context, req *wishlistv1.CreateWishlistRequest) (*wishlistv1.WishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	wishlist, err := s.wishlistService.CreateWishlist(ctx, user, req.Name)
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return toGRPCWishlistResponse(wishlist), nil
+}
+
+func (s *WishlistServer) GetWishlist(ctx context.Context, req *wishlistv1.GetWishlistRequest) (*wishlistv1.WishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	wishlist, err := s.wishlistService.GetWishlist(ctx, user, int(req.Id))
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return toGRPCWishlistResponse(wishlist), nil
+}
+
+func (s *WishlistServer) RenameWishlist(ctx context.Context, req *wishlistv1.RenameWishlistRequest) (*wishlistv1.WishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	wishlist, err := s.wishlistService.RenameWishlist(ctx, user, int(req.Id), req.Name)
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return toGRPCWishlistResponse(wishlist), nil
+}
+
+func (s *WishlistServer) DeleteWishlist(ctx context.Context, req *wishlistv1.DeleteWishlistRequest) (*wishlistv1.DeleteWishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	err = s.wishlistService.DeleteWishlist(ctx, user, int(req.Id))
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return &wishlistv1.DeleteWishlistResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *WishlistServer) AddWishlistItem(ctx context.Context, req *wishlistv1.AddWishlistItemRequest) (*wishlistv1.WishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	wishlist, err := s.wishlistService.AddBook(ctx, user, int(req.Id), int(req.BookId))
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return toGRPCWishlistResponse(wishlist), nil
+}
+
+func (s *WishlistServer) RemoveWishlistItem(ctx context.Context, req *wishlistv1.RemoveWishlistItemRequest) (*wishlistv1.WishlistResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	wishlist, err := s.wishlistService.RemoveBook(ctx, user, int(req.Id), int(req.BookId))
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	return toGRPCWishlistResponse(wishlist), nil
+}
+
+func (s *WishlistServer) MoveWishlistItemToCart(ctx context.Context, req *wishlistv1.MoveWishlistItemToCartRequest) (*wishlistv1.MoveWishlistItemToCartResponse, error) {
+	user, err := auth.GetUserFromGRPCMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required and must be greater than 0")
+	}
+
+	wishlist, items, err := s.wishlistService.MoveToCart(ctx, user, int(req.Id), int(req.BookId))
+	if err != nil {
+		return nil, toGRPCWishlistError(err)
+	}
+
+	cartData, itemsData := toGRPCCartItems(items)
+
+	return &wishlistv1.MoveWishlistItemToCartResponse{
+		Wishlist:  toGRPCWishlistResponse(wishlist),
+		Cart:      cartData,
+		CartItems: itemsData,
+	}, nil
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"encoding/json"
+	"log"
 	"net/http"
 	auth "toptal/internal/app/common/auth"
 	"toptal/internal/app/common/server"
@@ -58,5 +59,21 @@ func (s HttpServer) SignIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	server.RespondOK(map[string]string{"token": token}, w, r)
+	response := models.SignInResponse{Token: token}
+
+	// the guest cart moves to the signed in user
+	cartToken := authRequest.CartToken
+	if cartToken == "" {
+		cartToken = r.Header.Get(CartTokenHeader)
+	}
+	if cartToken != "" {
+		response.DroppedBookIDs, err = s.cartService.MergeGuestCart(r.Context(), user.ID(), cartToken)
+		if err != nil {
+			// the user is signed in already, a stale or broken guest cart doesn't fail the sign in
+			log.Printf("failed to merge guest cart of user %d: %v", user.ID(), err)
+			response.CartMergeFailed = true
+		}
+	}
+
+	server.RespondOK(response, w, r)
 }
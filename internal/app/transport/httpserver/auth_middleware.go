@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
 )
 
 const (
@@ -31,6 +32,8 @@ func (s HttpServer) CheckAdmin(next http.Handler) http.Handler {
 			server.Unauthorised("not-admin", nil, w, r)
 			return
 		}
+		setGatewayUserHeaders(r, user)
+
 		ctx := context.WithValue(r.Context(), ContextUserKey, user)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -56,16 +59,45 @@ func (s HttpServer) CheckAuthorizedUser(next http.Handler) http.Handler {
 			server.Unauthorised("invalid-token", err, w, r)
 			return
 		}
-		// Pass metadata through for the gRPC Gateway
-		r.Header.Set("user-id", strconv.Itoa(user.ID()))
-		r.Header.Set("user-email", user.Email())
-		r.Header.Set("user-admin", strconv.FormatBool(user.Admin()))
+		setGatewayUserHeaders(r, user)
 
 		ctx = context.WithValue(r.Context(), ContextUserKey, user)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// IdentifyUser puts the signed in user in the context of public routes, visitors without a valid token stay anonymous.
+// The gateway user headers sent by the client are dropped, so they can't be forged.
+func (s HttpServer) IdentifyUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Del("user-id")
+		r.Header.Del("user-email")
+		r.Header.Del("user-admin")
+
+		token, ok := extractBearerToken(r.Header.Get(AuthorizationHeader))
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		user, err := s.authService.GetUserFromToken(token)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		setGatewayUserHeaders(r, user)
+
+		ctx := context.WithValue(r.Context(), ContextUserKey, user)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// setGatewayUserHeaders passes the user through to the gRPC Gateway as metadata
+func setGatewayUserHeaders(r *http.Request, user domain.User) {
+	r.Header.Set("user-id", strconv.Itoa(user.ID()))
+	r.Header.Set("user-email", user.Email())
+	r.Header.Set("user-admin", strconv.FormatBool(user.Admin()))
+}
+
 func extractBearerToken(token string) (string, bool) {
 	if !strings.HasPrefix(token, BearerPrefix) {
 		return "", false
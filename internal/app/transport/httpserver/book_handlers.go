@@ -3,7 +3,9 @@ package httpserver
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	auth "toptal/internal/app/common/auth"
 	"toptal/internal/app/common/server"
@@ -14,37 +16,86 @@ import (
 )
 
 func (s HttpServer) GetBooks(w http.ResponseWriter, r *http.Request) {
-	// filter by category IDs
-	queryCategoryIDs := r.URL.Query()["category_id"]
-	var categoryIDs []int
-	for _, id := range queryCategoryIDs {
-		categoryID, err := strconv.Atoi(id)
-		if err != nil {
-			server.BadRequest("invalid-category-id", err, w, r)
-			return
-		}
-		categoryIDs = append(categoryIDs, categoryID)
+	// anonymous visitors list the books as the zero user
+	user, _ := getUserFromContext(r.Context())
+
+	filter, err := bookFilterFromQuery(r.URL.Query())
+	if err != nil {
+		respondWithBookFilterError(err, w, r)
+		return
 	}
-	// page
-	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	pageRequest, err := pageRequestFromQuery(r.URL.Query())
 	if err != nil {
-		page = 1
+		respondWithPageError(err, w, r)
+		return
 	}
-	var limit, offset int
-	if page > 0 {
-		limit = 10
-		offset = (page - 1) * limit
+
+	page, err := s.bookService.GetBooks(r.Context(), user, filter, pageRequest)
+	if err != nil {
+		if !respondWithPageError(err, w, r) {
+			server.RespondWithError(err, w, r)
+		}
+		return
 	}
 
-	books, err := s.bookService.GetBooks(r.Context(), categoryIDs, limit, offset)
+	response := make([]models.BookResponse, 0, len(page.Items()))
+	for _, book := range page.Items() {
+		response = append(response, auth.ToResponseBook(book))
+	}
+
+	setPageHeaders(w, page)
+	server.RespondOK(response, w, r)
+}
+
+// GetBookFacets returns the counts of the listed books per category, decade and price bucket.
+// It takes the same filter as GetBooks so that the counts match the listing the user is browsing.
+func (s HttpServer) GetBookFacets(w http.ResponseWriter, r *http.Request) {
+	// anonymous visitors count the books as the zero user
+	user, _ := getUserFromContext(r.Context())
+
+	filter, err := bookFilterFromQuery(r.URL.Query())
+	if err != nil {
+		respondWithBookFilterError(err, w, r)
+		return
+	}
+
+	facets, err := s.bookService.GetBookFacets(r.Context(), user, filter)
 	if err != nil {
 		server.RespondWithError(err, w, r)
 		return
 	}
 
-	response := make([]models.BookResponse, 0, len(books))
-	for _, book := range books {
-		response = append(response, auth.ToResponseBook(book))
+	server.RespondOK(auth.ToResponseBookFacets(facets), w, r)
+}
+
+// SuggestBooks returns the titles and authors resembling the prefix the user is typing
+func (s HttpServer) SuggestBooks(w http.ResponseWriter, r *http.Request) {
+	var limit int
+	if queryLimit := r.URL.Query().Get("limit"); queryLimit != "" {
+		var err error
+		limit, err = strconv.Atoi(queryLimit)
+		if err != nil {
+			server.BadRequest("invalid-limit", err, w, r)
+			return
+		}
+	}
+
+	suggestions, err := s.bookService.SuggestBooks(r.Context(), r.URL.Query().Get("prefix"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidSuggestPrefix):
+			server.BadRequest("invalid-prefix", err, w, r)
+		case errors.Is(err, domain.ErrInvalidLimit):
+			server.BadRequest("invalid-limit", err, w, r)
+		default:
+			server.RespondWithError(err, w, r)
+		}
+		return
+	}
+
+	response := make([]models.BookSuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		response = append(response, auth.ToResponseBookSuggestion(suggestion))
 	}
 
 	server.RespondOK(response, w, r)
@@ -122,12 +173,13 @@ func (s HttpServer) UpdateBook(w http.ResponseWriter, r *http.Request) {
 	}
 
 	book, err := domain.NewBook(domain.NewBookData{
-		ID:         bookID,
-		Title:      bookRequest.Title,
-		Year:       bookRequest.Year,
-		Author:     bookRequest.Author,
-		Price:      bookRequest.Price,
-		CategoryID: bookRequest.CategoryID,
+		ID:          bookID,
+		Title:       bookRequest.Title,
+		Year:        bookRequest.Year,
+		Author:      bookRequest.Author,
+		Price:       bookRequest.Price,
+		CategoryID:  bookRequest.CategoryID,
+		CategoryIDs: bookRequest.CategoryIDs,
 	})
 	if err != nil {
 		server.RespondWithError(err, w, r)
@@ -176,3 +228,94 @@ func (s HttpServer) DeleteBook(w http.ResponseWriter, r *http.Request) {
 
 	server.RespondOK(map[string]bool{"deleted": true}, w, r)
 }
+
+// bookFilterFromQuery reads the book filter from the query parameters, malformed numbers fail with the error of their filter
+func bookFilterFromQuery(query url.Values) (domain.BookFilter, error) {
+	var categoryIDs []int
+	for _, id := range query["category_id"] {
+		categoryID, err := strconv.Atoi(id)
+		if err != nil {
+			return domain.BookFilter{}, fmt.Errorf("%w: category_id", domain.ErrNegative)
+		}
+		categoryIDs = append(categoryIDs, categoryID)
+	}
+
+	yearMin, err := queryInt(query, "year_min", domain.ErrInvalidYearRange)
+	if err != nil {
+		return domain.BookFilter{}, err
+	}
+	yearMax, err := queryInt(query, "year_max", domain.ErrInvalidYearRange)
+	if err != nil {
+		return domain.BookFilter{}, err
+	}
+	priceMin, err := queryInt(query, "price_min", domain.ErrInvalidPriceRange)
+	if err != nil {
+		return domain.BookFilter{}, err
+	}
+	priceMax, err := queryInt(query, "price_max", domain.ErrInvalidPriceRange)
+	if err != nil {
+		return domain.BookFilter{}, err
+	}
+
+	var includeSoldOut bool
+	if value := query.Get("include_sold_out"); value != "" {
+		includeSoldOut, err = strconv.ParseBool(value)
+		if err != nil {
+			return domain.BookFilter{}, fmt.Errorf("%w: include_sold_out", domain.ErrInvalidBookFilter)
+		}
+	}
+	var includeSubcategories bool
+	if value := query.Get("include_subcategories"); value != "" {
+		includeSubcategories, err = strconv.ParseBool(value)
+		if err != nil {
+			return domain.BookFilter{}, fmt.Errorf("%w: include_subcategories", domain.ErrInvalidBookFilter)
+		}
+	}
+
+	return domain.NewBookFilter(domain.NewBookFilterData{
+		CategoryIDs:          categoryIDs,
+		IncludeSubcategories: includeSubcategories,
+		Query:                query.Get("q"),
+		Author:               query.Get("author"),
+		AuthorContains:       query.Get("author_contains"),
+		YearMin:              yearMin,
+		YearMax:              yearMax,
+		PriceMin:             priceMin,
+		PriceMax:             priceMax,
+		IncludeSoldOut:       includeSoldOut,
+		Sort:                 domain.BookSort(query.Get("sort")),
+	})
+}
+
+// queryInt reads an optional number from the query parameters, zero when it's missing
+func queryInt(query url.Values, name string, invalid error) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s is not a number", invalid, name)
+	}
+
+	return number, nil
+}
+
+func respondWithBookFilterError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, domain.ErrNegative):
+		server.BadRequest("invalid-category-id", err, w, r)
+	case errors.Is(err, domain.ErrInvalidSearchQuery):
+		server.BadRequest("invalid-search-query", err, w, r)
+	case errors.Is(err, domain.ErrInvalidAuthorFilter):
+		server.BadRequest("invalid-author", err, w, r)
+	case errors.Is(err, domain.ErrInvalidYearRange):
+		server.BadRequest("invalid-year-range", err, w, r)
+	case errors.Is(err, domain.ErrInvalidPriceRange):
+		server.BadRequest("invalid-price-range", err, w, r)
+	case errors.Is(err, domain.ErrInvalidBookSort):
+		server.BadRequest("invalid-sort", err, w, r)
+	default:
+		server.BadRequest("invalid-filter", err, w, r)
+	}
+}
@@ -3,13 +3,35 @@ package httpserver
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
 	auth "toptal/internal/app/common/auth"
 	"toptal/internal/app/common/server"
 	"toptal/internal/app/domain"
 	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
 )
 
+func (s HttpServer) GetCart(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	items, err := s.cartService.GetCart(r.Context(), user.ID())
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	response := auth.ToResponseCartItems(items)
+
+	server.RespondOK(response, w, r)
+}
+
 func (s HttpServer) UpdateCart(w http.ResponseWriter, r *http.Request) {
 	user, err := getUserFromContext(r.Context())
 	if err != nil {
@@ -40,6 +62,14 @@ func (s HttpServer) UpdateCart(w http.ResponseWriter, r *http.Request) {
 			server.BadRequest("missing-book-ids", err, w, r)
 			return
 		}
+		if errors.Is(err, domain.ErrInvalidBookIDs) {
+			server.BadRequest("invalid-book-ids", err, w, r)
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidQuantity) {
+			server.BadRequest("invalid-quantity", err, w, r)
+			return
+		}
 		if errors.Is(err, domain.ErrInvalidUserID) {
 			server.BadRequest("invalid-user-id", err, w, r)
 			return
@@ -48,17 +78,105 @@ func (s HttpServer) UpdateCart(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedCart, err := s.cartService.UpdateCartAndStocks(r.Context(), cart)
+	items, err := s.cartService.UpdateCartAndStocks(r.Context(), cart)
 	if err != nil {
 		server.RespondWithError(err, w, r)
 		return
 	}
 
-	response := auth.ToResponseCart(updatedCart)
+	response := auth.ToResponseCartItems(items)
 
 	server.RespondOK(response, w, r)
 }
 
+// AddCartItem reserves copies of one book in the cart, the quantity in the body defaults to one
+func (s HttpServer) AddCartItem(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	quantity := 1
+	if r.ContentLength != 0 {
+		var quantityRequest models.CartQuantityRequest
+		err := json.NewDecoder(r.Body).Decode(&quantityRequest)
+		if err != nil && !errors.Is(err, io.EOF) {
+			server.BadRequest("invalid-json", err, w, r)
+			return
+		}
+		if quantityRequest.Quantity != nil {
+			quantity = *quantityRequest.Quantity
+		}
+	}
+
+	items, err := s.cartService.AddBook(r.Context(), user.ID(), bookID, quantity)
+	if err != nil {
+		respondWithCartItemError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseCartItems(items), w, r)
+}
+
+// RemoveCartItem releases one book from the cart
+func (s HttpServer) RemoveCartItem(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	items, err := s.cartService.RemoveBook(r.Context(), user.ID(), bookID)
+	if err != nil {
+		respondWithCartItemError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseCartItems(items), w, r)
+}
+
+// ClearCart empties the cart and releases every reservation
+func (s HttpServer) ClearCart(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	err = s.cartService.ClearCart(r.Context(), user.ID())
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(map[string]bool{"deleted": true}, w, r)
+}
+
+func respondWithCartItemError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, domain.ErrNegative) {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidQuantity) {
+		server.BadRequest("invalid-quantity", err, w, r)
+		return
+	}
+	server.RespondWithError(err, w, r)
+}
+
 func (s HttpServer) Checkout(w http.ResponseWriter, r *http.Request) {
 	user, err := getUserFromContext(r.Context())
 	if err != nil {
@@ -66,11 +184,13 @@ func (s HttpServer) Checkout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.cartService.Checkout(r.Context(), user.ID())
+	order, err := s.cartService.Checkout(r.Context(), user.ID())
 	if err != nil {
 		server.RespondWithError(err, w, r)
 		return
 	}
 
-	server.RespondOK(map[string]bool{"ok": true}, w, r)
+	response := auth.ToResponseOrder(order)
+
+	server.RespondOK(response, w, r)
 }
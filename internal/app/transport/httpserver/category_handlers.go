@@ -14,20 +14,40 @@ import (
 )
 
 func (s HttpServer) GetCategories(w http.ResponseWriter, r *http.Request) {
-	categories, err := s.categoryService.GetCategories(r.Context())
+	pageRequest, err := pageRequestFromQuery(r.URL.Query())
 	if err != nil {
-		server.RespondWithError(err, w, r)
+		respondWithPageError(err, w, r)
 		return
 	}
 
-	response := make([]models.CategoryResponse, 0, len(categories))
-	for _, category := range categories {
+	page, err := s.categoryService.GetCategories(r.Context(), pageRequest)
+	if err != nil {
+		if !respondWithPageError(err, w, r) {
+			server.RespondWithError(err, w, r)
+		}
+		return
+	}
+
+	response := make([]models.CategoryResponse, 0, len(page.Items()))
+	for _, category := range page.Items() {
 		response = append(response, auth.ToResponseCategory(category))
 	}
 
+	setPageHeaders(w, page)
 	server.RespondOK(response, w, r)
 }
 
+// GetCategoryTree returns all the categories nested under their parents
+func (s HttpServer) GetCategoryTree(w http.ResponseWriter, r *http.Request) {
+	tree, err := s.categoryService.GetCategoryTree(r.Context())
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseCategoryTree(tree), w, r)
+}
+
 // GetCategory returns a category by ID
 func (s HttpServer) GetCategory(w http.ResponseWriter, r *http.Request) {
 	categoryIDParam := chi.URLParam(r, "category_id")
@@ -51,6 +71,23 @@ func (s HttpServer) GetCategory(w http.ResponseWriter, r *http.Request) {
 	server.RespondOK(response, w, r)
 }
 
+// GetCategoryBySlug returns a category by its slug for the storefront URLs
+func (s HttpServer) GetCategoryBySlug(w http.ResponseWriter, r *http.Request) {
+	category, err := s.categoryService.GetCategoryBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			server.NotFound("category-not-found", err, w, r)
+			return
+		}
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	response := auth.ToResponseCategory(category)
+
+	server.RespondOK(response, w, r)
+}
+
 // CreateCategory creates a new category
 func (s HttpServer) CreateCategory(w http.ResponseWriter, r *http.Request) {
 	var categoryRequest models.CategoryRequest
@@ -59,17 +96,35 @@ func (s HttpServer) CreateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var parentID, displayOrder int
+	var slug, description string
+	if categoryRequest.ParentID != nil {
+		parentID = *categoryRequest.ParentID
+	}
+	if categoryRequest.Slug != nil {
+		slug = *categoryRequest.Slug
+	}
+	if categoryRequest.Description != nil {
+		description = *categoryRequest.Description
+	}
+	if categoryRequest.DisplayOrder != nil {
+		displayOrder = *categoryRequest.DisplayOrder
+	}
 	category, err := domain.NewCategory(domain.NewCategoryData{
-		Name: categoryRequest.Name,
+		Name:         categoryRequest.Name,
+		ParentID:     parentID,
+		Slug:         slug,
+		Description:  description,
+		DisplayOrder: displayOrder,
 	})
 	if err != nil {
-		server.RespondWithError(err, w, r)
+		respondWithCategoryError(err, w, r)
 		return
 	}
 
 	insertedCategory, err := s.categoryService.CreateCategory(r.Context(), category)
 	if err != nil {
-		server.RespondWithError(err, w, r)
+		respondWithCategoryError(err, w, r)
 		return
 	}
 
@@ -92,7 +147,7 @@ func (s HttpServer) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = s.categoryService.GetCategory(r.Context(), categoryID)
+	existingCategory, err := s.categoryService.GetCategory(r.Context(), categoryID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			server.NotFound("category-not-found", err, w, r)
@@ -102,18 +157,38 @@ func (s HttpServer) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	parentID := existingCategory.ParentID()
+	if categoryRequest.ParentID != nil {
+		parentID = *categoryRequest.ParentID
+	}
+	slug := existingCategory.Slug()
+	if categoryRequest.Slug != nil {
+		slug = *categoryRequest.Slug
+	}
+	description := existingCategory.Description()
+	if categoryRequest.Description != nil {
+		description = *categoryRequest.Description
+	}
+	displayOrder := existingCategory.DisplayOrder()
+	if categoryRequest.DisplayOrder != nil {
+		displayOrder = *categoryRequest.DisplayOrder
+	}
 	category, err := domain.NewCategory(domain.NewCategoryData{
-		ID:   categoryID,
-		Name: categoryRequest.Name,
+		ID:           categoryID,
+		Name:         categoryRequest.Name,
+		ParentID:     parentID,
+		Slug:         slug,
+		Description:  description,
+		DisplayOrder: displayOrder,
 	})
 	if err != nil {
-		server.RespondWithError(err, w, r)
+		respondWithCategoryError(err, w, r)
 		return
 	}
 
 	updatedCategory, err := s.categoryService.UpdateCategory(r.Context(), category)
 	if err != nil {
-		server.RespondWithError(err, w, r)
+		respondWithCategoryError(err, w, r)
 		return
 	}
 
@@ -140,15 +215,64 @@ func (s HttpServer) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.categoryService.DeleteCategory(r.Context(), categoryID)
+	var reassignTo int
+	if value := r.URL.Query().Get("reassign_to"); value != "" {
+		reassignTo, err = strconv.Atoi(value)
+		if err != nil {
+			server.BadRequest("invalid-delete-policy", err, w, r)
+			return
+		}
+	}
+	deletion, err := domain.NewCategoryDeletion(domain.NewCategoryDeletionData{
+		Policy:     domain.CategoryDeletePolicy(r.URL.Query().Get("policy")),
+		ReassignTo: reassignTo,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrNotFound) {
+		server.BadRequest("invalid-delete-policy", err, w, r)
+		return
+	}
+
+	affected, err := s.categoryService.DeleteCategory(r.Context(), categoryID, deletion)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
 			server.NotFound("category-not-found", err, w, r)
-			return
+		case errors.Is(err, domain.ErrCategoryNotEmpty):
+			server.BadRequest("category-not-empty", err, w, r)
+		case errors.Is(err, domain.ErrReassignTargetNotFound):
+			server.BadRequest("reassign-target-not-found", err, w, r)
+		case errors.Is(err, domain.ErrInvalidDeletePolicy):
+			server.BadRequest("invalid-delete-policy", err, w, r)
+		default:
+			server.RespondWithError(err, w, r)
 		}
-		server.RespondWithError(err, w, r)
 		return
 	}
 
-	server.RespondOK(map[string]bool{"deleted": true}, w, r)
+	server.RespondOK(models.DeleteCategoryResponse{
+		Deleted:       true,
+		Policy:        string(deletion.Policy()),
+		AffectedBooks: affected,
+	}, w, r)
+}
+
+// respondWithCategoryError responds to the invalid categories and the parents that would break the category tree
+// with their slugs
+func respondWithCategoryError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCategorySlug):
+		server.BadRequest("invalid-category-slug", err, w, r)
+	case errors.Is(err, domain.ErrCategorySlugTaken):
+		server.BadRequest("category-slug-taken", err, w, r)
+	case errors.Is(err, domain.ErrInvalidCategoryDescription):
+		server.BadRequest("invalid-category-description", err, w, r)
+	case errors.Is(err, domain.ErrCategoryCycle):
+		server.BadRequest("category-cycle", err, w, r)
+	case errors.Is(err, domain.ErrParentCategoryNotFound):
+		server.BadRequest("parent-category-not-found", err, w, r)
+	case errors.Is(err, domain.ErrNegative):
+		server.BadRequest("invalid-parent-id", err, w, r)
+	default:
+		server.RespondWithError(err, w, r)
+	}
 }
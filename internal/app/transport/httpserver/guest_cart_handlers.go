@@ -0,0 +1,99 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	auth "toptal/internal/app/common/auth"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CartTokenHeader carries the token of a guest cart in both directions
+const CartTokenHeader = "X-Cart-Token"
+
+// GetGuestCart returns the cart of an anonymous visitor, a visitor without a token has an empty cart
+func (s HttpServer) GetGuestCart(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get(CartTokenHeader)
+	if token == "" {
+		server.RespondOK(auth.ToResponseCartItems(nil), w, r)
+		return
+	}
+
+	items, err := s.cartService.GetGuestCart(r.Context(), token)
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	respondWithGuestCart(token, auth.ToResponseCartItems(items), w, r)
+}
+
+// AddGuestCartItem reserves copies of one book in the guest cart, a request without a token starts a new cart
+func (s HttpServer) AddGuestCartItem(w http.ResponseWriter, r *http.Request) {
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	quantity := 1
+	if r.ContentLength != 0 {
+		var quantityRequest models.CartQuantityRequest
+		err := json.NewDecoder(r.Body).Decode(&quantityRequest)
+		if err != nil && !errors.Is(err, io.EOF) {
+			server.BadRequest("invalid-json", err, w, r)
+			return
+		}
+		if quantityRequest.Quantity != nil {
+			quantity = *quantityRequest.Quantity
+		}
+	}
+
+	token, items, err := s.cartService.AddGuestBook(r.Context(), r.Header.Get(CartTokenHeader), bookID, quantity)
+	if err != nil {
+		respondWithCartItemError(err, w, r)
+		return
+	}
+
+	respondWithGuestCart(token, auth.ToResponseCartItems(items), w, r)
+}
+
+// RemoveGuestCartItem releases one book from the guest cart
+func (s HttpServer) RemoveGuestCartItem(w http.ResponseWriter, r *http.Request) {
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	token := r.Header.Get(CartTokenHeader)
+	items, err := s.cartService.RemoveGuestBook(r.Context(), token, bookID)
+	if err != nil {
+		respondWithCartItemError(err, w, r)
+		return
+	}
+
+	respondWithGuestCart(token, auth.ToResponseCartItems(items), w, r)
+}
+
+// ClearGuestCart empties the guest cart and releases every reservation
+func (s HttpServer) ClearGuestCart(w http.ResponseWriter, r *http.Request) {
+	err := s.cartService.ClearGuestCart(r.Context(), r.Header.Get(CartTokenHeader))
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(map[string]bool{"deleted": true}, w, r)
+}
+
+func respondWithGuestCart(token string, response models.CartResponse, w http.ResponseWriter, r *http.Request) {
+	response.CartToken = token
+	w.Header().Set(CartTokenHeader, token)
+	server.RespondOK(response, w, r)
+}
@@ -152,6 +152,11 @@ func setupTestServer(t *testing.T) *httpserver.HttpServer {
 		bookService, // bookService - service being tested
 		nil,         // cartService - not needed for this test
 		nil,         // categoryService - not needed for this test
+		nil,         // orderService - not needed for this test
+		nil,         // idempotencyService - not needed for this test
+		nil,         // wishlistService - not needed for this test
+		nil,         // notificationService - not needed for this test
+		nil,         // inventoryService - not needed for this test
 	)
 }
 
@@ -0,0 +1,88 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"toptal/internal/app/common/server"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotent makes a request sent with the Idempotency-Key header safe to retry:
+// a retry of a successful request gets the stored response and is not applied again.
+// It has to run after CheckAuthorizedUser, since keys belong to the user.
+func (s HttpServer) Idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := getUserFromContext(r.Context())
+		if err != nil {
+			server.BadRequest("invalid-user", err, w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			server.BadRequest("invalid-request-body", err, w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		request := []byte(r.Method + " " + r.URL.Path + "\n")
+		request = append(request, body...)
+
+		storedKey, replay, err := s.idempotencyService.Begin(r.Context(), user.ID(), key, request)
+		if err != nil {
+			server.RespondWithError(err, w, r)
+			return
+		}
+		if replay {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Header().Set(IdempotentReplayedHeader, "true")
+			w.WriteHeader(storedKey.StatusCode())
+			_, _ = w.Write(storedKey.Response())
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		// the response is already sent, so the key is saved even if the client went away
+		ctx := context.WithoutCancel(r.Context())
+		if recorder.statusCode >= http.StatusOK && recorder.statusCode < http.StatusMultipleChoices {
+			err = s.idempotencyService.Complete(ctx, storedKey, recorder.statusCode, recorder.body.Bytes())
+		} else {
+			err = s.idempotencyService.Abandon(ctx, storedKey)
+		}
+		if err != nil {
+			log.Printf("failed to save idempotency key: %v", err)
+		}
+	})
+}
+
+// responseRecorder writes the response to the client and keeps a copy of it
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
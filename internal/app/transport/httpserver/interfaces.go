@@ -14,7 +14,9 @@ type UserService interface {
 type BookService interface {
 	CreateBook(ctx context.Context, data domain.Book) (domain.Book, error)
 	GetBook(ctx context.Context, in int) (domain.Book, error)
-	GetBooks(ctx context.Context, categoryIDs []int, limit, offset int) ([]domain.Book, error)
+	GetBooks(ctx context.Context, actor domain.User, filter domain.BookFilter, page domain.PageRequest) (domain.Page[domain.Book], error)
+	GetBookFacets(ctx context.Context, actor domain.User, filter domain.BookFilter) (domain.BookFacets, error)
+	SuggestBooks(ctx context.Context, prefix string, limit int) ([]domain.BookSuggestion, error)
 	UpdateBook(ctx context.Context, book domain.Book) (domain.Book, error)
 	DeleteBook(ctx context.Context, id int) error
 }
@@ -22,14 +24,61 @@ type BookService interface {
 type CategoryService interface {
 	CreateCategory(ctx context.Context, category domain.Category) (domain.Category, error)
 	GetCategory(ctx context.Context, id int) (domain.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (domain.Category, error)
 	UpdateCategory(ctx context.Context, category domain.Category) (domain.Category, error)
-	DeleteCategory(ctx context.Context, id int) error
-	GetCategories(ctx context.Context) ([]domain.Category, error)
+	DeleteCategory(ctx context.Context, id int, deletion domain.CategoryDeletion) (int, error)
+	GetCategories(ctx context.Context, page domain.PageRequest) (domain.Page[domain.Category], error)
+	GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error)
 }
 
 type CartService interface {
-	UpdateCartAndStocks(ctx context.Context, cart domain.Cart) (domain.Cart, error)
-	Checkout(ctx context.Context, userID int) error
+	GetCart(ctx context.Context, userID int) ([]domain.CartItem, error)
+	UpdateCartAndStocks(ctx context.Context, cart domain.Cart) ([]domain.CartItem, error)
+	AddBook(ctx context.Context, userID, bookID, quantity int) ([]domain.CartItem, error)
+	RemoveBook(ctx context.Context, userID, bookID int) ([]domain.CartItem, error)
+	ClearCart(ctx context.Context, userID int) error
+	Checkout(ctx context.Context, userID int) (domain.Order, error)
+	GetGuestCart(ctx context.Context, token string) ([]domain.CartItem, error)
+	AddGuestBook(ctx context.Context, token string, bookID, quantity int) (string, []domain.CartItem, error)
+	RemoveGuestBook(ctx context.Context, token string, bookID int) ([]domain.CartItem, error)
+	ClearGuestCart(ctx context.Context, token string) error
+	MergeGuestCart(ctx context.Context, userID int, token string) ([]int, error)
+}
+
+type OrderService interface {
+	GetOrder(ctx context.Context, user domain.User, id int) (domain.Order, error)
+	GetOrders(ctx context.Context, user domain.User, userID int, page domain.PageRequest) (domain.Page[domain.Order], error)
+	ChangeOrderStatus(ctx context.Context, actor domain.User, id int, status domain.OrderStatus) (domain.Order, error)
+	CancelOrder(ctx context.Context, actor domain.User, id int) (domain.Order, error)
+}
+
+type WishlistService interface {
+	GetWishlists(ctx context.Context, user domain.User) ([]domain.Wishlist, error)
+	GetWishlist(ctx context.Context, user domain.User, id int) (domain.Wishlist, error)
+	CreateWishlist(ctx context.Context, user domain.User, name string) (domain.Wishlist, error)
+	RenameWishlist(ctx context.Context, user domain.User, id int, name string) (domain.Wishlist, error)
+	DeleteWishlist(ctx context.Context, user domain.User, id int) error
+	AddBook(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, error)
+	RemoveBook(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, error)
+	MoveToCart(ctx context.Context, user domain.User, id, bookID int) (domain.Wishlist, []domain.CartItem, error)
+}
+
+type NotificationService interface {
+	Subscribe(ctx context.Context, userID, bookID int) (domain.BookSubscription, error)
+	Unsubscribe(ctx context.Context, userID, bookID int) error
+	GetSubscriptions(ctx context.Context, userID int) ([]domain.BookSubscription, error)
+}
+
+type InventoryService interface {
+	Restock(ctx context.Context, actor domain.User, bookID, quantity int, reason string) (domain.Book, error)
+	GetInventory(ctx context.Context, actor domain.User, bookID int) (domain.Inventory, error)
+	ReconcileStocks(ctx context.Context, actor domain.User, fix bool) ([]domain.StockReconciliation, error)
+}
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, userID int, key string, request []byte) (domain.IdempotencyKey, bool, error)
+	Complete(ctx context.Context, key domain.IdempotencyKey, statusCode int, response []byte) error
+	Abandon(ctx context.Context, key domain.IdempotencyKey) error
 }
 
 type AuthService interface {
@@ -0,0 +1,107 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	auth "toptal/internal/app/common/auth"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RestockBook adds a received shipment to the stock of a book and records it in the inventory ledger
+func (s HttpServer) RestockBook(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	var restockRequest models.RestockRequest
+	if err := json.NewDecoder(r.Body).Decode(&restockRequest); err != nil {
+		server.BadRequest("invalid-json", err, w, r)
+		return
+	}
+
+	book, err := s.inventoryService.Restock(r.Context(), user, bookID, restockRequest.Quantity, restockRequest.Reason)
+	if err != nil {
+		respondWithInventoryError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseBook(book), w, r)
+}
+
+// GetBookInventory returns the stock of a book, the stock derived from the inventory ledger and the latest movements
+func (s HttpServer) GetBookInventory(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	inventory, err := s.inventoryService.GetInventory(r.Context(), user, bookID)
+	if err != nil {
+		respondWithInventoryError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseInventory(inventory), w, r)
+}
+
+// GetStockReconciliation reports the books whose stock doesn't match the one expected from restocks, reservations and sales
+func (s HttpServer) GetStockReconciliation(w http.ResponseWriter, r *http.Request) {
+	s.reconcileStocks(false, w, r)
+}
+
+// FixStockReconciliation corrects the stocks that don't match the expected ones and reports them
+func (s HttpServer) FixStockReconciliation(w http.ResponseWriter, r *http.Request) {
+	s.reconcileStocks(true, w, r)
+}
+
+func (s HttpServer) reconcileStocks(fix bool, w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	reconciliations, err := s.inventoryService.ReconcileStocks(r.Context(), user, fix)
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseStockReconciliation(reconciliations), w, r)
+}
+
+func respondWithInventoryError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("book-not-found", err, w, r)
+	case errors.Is(err, domain.ErrNegative):
+		server.BadRequest("invalid-book-id", err, w, r)
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		server.BadRequest("invalid-quantity", err, w, r)
+	case errors.Is(err, domain.ErrInvalidMovementReason):
+		server.BadRequest("invalid-reason", err, w, r)
+	default:
+		server.RespondWithError(err, w, r)
+	}
+}
@@ -0,0 +1,159 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	auth "toptal/internal/app/common/auth"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetOrders returns the orders of the current user, admins can pick any user with user_id
+func (s HttpServer) GetOrders(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	var userID int
+	if userIDParam := r.URL.Query().Get("user_id"); userIDParam != "" {
+		userID, err = strconv.Atoi(userIDParam)
+		if err != nil {
+			server.BadRequest("invalid-user-id", err, w, r)
+			return
+		}
+	}
+	// unlike the other listings a malformed page number is rejected, it used to list every order
+	if pageParam := r.URL.Query().Get("page"); pageParam != "" {
+		page, err := strconv.Atoi(pageParam)
+		if err != nil || page < 0 {
+			server.BadRequest("invalid-page", fmt.Errorf("%w: %q", domain.ErrInvalidPageRequest, pageParam), w, r)
+			return
+		}
+	}
+	pageRequest, err := pageRequestFromQuery(r.URL.Query())
+	if err != nil {
+		respondWithPageError(err, w, r)
+		return
+	}
+
+	page, err := s.orderService.GetOrders(r.Context(), user, userID, pageRequest)
+	if err != nil {
+		if !respondWithPageError(err, w, r) {
+			server.RespondWithError(err, w, r)
+		}
+		return
+	}
+
+	response := make([]models.OrderResponse, 0, len(page.Items()))
+	for _, order := range page.Items() {
+		response = append(response, auth.ToResponseOrder(order))
+	}
+
+	setPageHeaders(w, page)
+	server.RespondOK(response, w, r)
+}
+
+// GetOrder returns an order by ID
+func (s HttpServer) GetOrder(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	orderIDParam := chi.URLParam(r, "order_id")
+	orderID, err := strconv.Atoi(orderIDParam)
+	if err != nil {
+		server.BadRequest("invalid-order-id", err, w, r)
+		return
+	}
+
+	order, err := s.orderService.GetOrder(r.Context(), user, orderID)
+	if err != nil {
+		respondWithOrderError(err, w, r)
+		return
+	}
+
+	response := auth.ToResponseOrder(order)
+
+	server.RespondOK(response, w, r)
+}
+
+// UpdateOrderStatus moves an order to the next status
+func (s HttpServer) UpdateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	orderIDParam := chi.URLParam(r, "order_id")
+	orderID, err := strconv.Atoi(orderIDParam)
+	if err != nil {
+		server.BadRequest("invalid-order-id", err, w, r)
+		return
+	}
+
+	var statusRequest models.OrderStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&statusRequest); err != nil {
+		server.BadRequest("invalid-json", err, w, r)
+		return
+	}
+
+	order, err := s.orderService.ChangeOrderStatus(r.Context(), user, orderID, domain.OrderStatus(statusRequest.Status))
+	if err != nil {
+		respondWithOrderError(err, w, r)
+		return
+	}
+
+	response := auth.ToResponseOrder(order)
+
+	server.RespondOK(response, w, r)
+}
+
+// CancelOrder cancels an order that has not been shipped yet
+func (s HttpServer) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	orderIDParam := chi.URLParam(r, "order_id")
+	orderID, err := strconv.Atoi(orderIDParam)
+	if err != nil {
+		server.BadRequest("invalid-order-id", err, w, r)
+		return
+	}
+
+	order, err := s.orderService.CancelOrder(r.Context(), user, orderID)
+	if err != nil {
+		respondWithOrderError(err, w, r)
+		return
+	}
+
+	response := auth.ToResponseOrder(order)
+
+	server.RespondOK(response, w, r)
+}
+
+func respondWithOrderError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("order-not-found", err, w, r)
+	case errors.Is(err, domain.ErrInvalidOrderStatus):
+		server.BadRequest("invalid-order-status", err, w, r)
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		server.BadRequest("invalid-status-transition", err, w, r)
+	default:
+		server.RespondWithError(err, w, r)
+	}
+}
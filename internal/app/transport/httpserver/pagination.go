@@ -0,0 +1,72 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
+)
+
+const (
+	// NextCursorHeader carries the cursor of the next page of a listing, it's missing on the last page
+	NextCursorHeader = "X-Next-Cursor"
+	// TotalCountHeader carries the number of all items of a listing when with_total=true is asked for
+	TotalCountHeader = "X-Total-Count"
+)
+
+// pageRequestFromQuery reads the page_size, cursor, with_total and the legacy page query parameters
+func pageRequestFromQuery(query url.Values) (domain.PageRequest, error) {
+	size, err := queryInt(query, "page_size", domain.ErrInvalidPageSize)
+	if err != nil {
+		return domain.PageRequest{}, err
+	}
+	// the page number used to be the only way to page, malformed numbers fall back to the first page as they did
+	number, err := strconv.Atoi(query.Get("page"))
+	if err != nil || number < 0 {
+		number = 0
+	}
+
+	var withTotal bool
+	if value := query.Get("with_total"); value != "" {
+		withTotal, err = strconv.ParseBool(value)
+		if err != nil {
+			return domain.PageRequest{}, fmt.Errorf("%w: with_total", domain.ErrInvalidPageRequest)
+		}
+	}
+
+	return domain.NewPageRequest(domain.NewPageRequestData{
+		Size:      size,
+		Number:    number,
+		Cursor:    query.Get("cursor"),
+		WithTotal: withTotal,
+	})
+}
+
+// setPageHeaders passes the cursor of the next page and the total count of the listing in the response headers,
+// so the body stays the list of the items
+func setPageHeaders[T any](w http.ResponseWriter, page domain.Page[T]) {
+	if page.NextCursor() != "" {
+		w.Header().Set(NextCursorHeader, page.NextCursor())
+	}
+	if total, ok := page.Total(); ok {
+		w.Header().Set(TotalCountHeader, strconv.Itoa(total))
+	}
+}
+
+// respondWithPageError responds to malformed page requests and cursors with their slugs, it reports whether it responded
+func respondWithPageError(err error, w http.ResponseWriter, r *http.Request) bool {
+	switch {
+	case errors.Is(err, domain.ErrInvalidPageSize):
+		server.BadRequest("invalid-page-size", err, w, r)
+	case errors.Is(err, domain.ErrInvalidCursor):
+		server.BadRequest("invalid-cursor", err, w, r)
+	case errors.Is(err, domain.ErrInvalidPageRequest):
+		server.BadRequest("invalid-page", err, w, r)
+	default:
+		return false
+	}
+	return true
+}
@@ -3,23 +3,38 @@ package httpserver
 import "toptal/internal/app/transport/interfaces"
 
 type HttpServer struct {
-	userService     interfaces.UserService
-	authService     interfaces.AuthService
-	bookService     interfaces.BookService
-	cartService     interfaces.CartService
-	categoryService interfaces.CategoryService
+	userService         interfaces.UserService
+	authService         interfaces.AuthService
+	bookService         interfaces.BookService
+	cartService         interfaces.CartService
+	categoryService     interfaces.CategoryService
+	orderService        interfaces.OrderService
+	idempotencyService  interfaces.IdempotencyService
+	wishlistService     interfaces.WishlistService
+	notificationService interfaces.NotificationService
+	inventoryService    interfaces.InventoryService
 }
 
 func NewHttpServer(userService interfaces.UserService,
 	authService interfaces.AuthService,
 	bookService interfaces.BookService,
 	cartService interfaces.CartService,
-	categoryService interfaces.CategoryService) *HttpServer {
+	categoryService interfaces.CategoryService,
+	orderService interfaces.OrderService,
+	idempotencyService interfaces.IdempotencyService,
+	wishlistService interfaces.WishlistService,
+	notificationService interfaces.NotificationService,
+	inventoryService interfaces.InventoryService) *HttpServer {
 	return &HttpServer{
-		userService:     userService,
-		authService:     authService,
-		bookService:     bookService,
-		cartService:     cartService,
-		categoryService: categoryService,
+		userService:         userService,
+		authService:         authService,
+		bookService:         bookService,
+		cartService:         cartService,
+		categoryService:     categoryService,
+		orderService:        orderService,
+		idempotencyService:  idempotencyService,
+		wishlistService:     wishlistService,
+		notificationService: notificationService,
+		inventoryService:    inventoryService,
 	}
 }
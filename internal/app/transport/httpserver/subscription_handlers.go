@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	auth "toptal/internal/app/common/auth"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetSubscriptions returns the sold-out books the current user waits for
+func (s HttpServer) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	subscriptions, err := s.notificationService.GetSubscriptions(r.Context(), user.ID())
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	response := make([]models.SubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, auth.ToResponseSubscription(subscription))
+	}
+
+	server.RespondOK(response, w, r)
+}
+
+// Subscribe asks to notify the current user once the sold-out book is back in stock
+func (s HttpServer) Subscribe(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	subscription, err := s.notificationService.Subscribe(r.Context(), user.ID(), bookID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNegative) {
+			server.BadRequest("invalid-book-id", err, w, r)
+			return
+		}
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseSubscription(subscription), w, r)
+}
+
+// Unsubscribe cancels the subscription of the current user to the book
+func (s HttpServer) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return
+	}
+
+	err = s.notificationService.Unsubscribe(r.Context(), user.ID(), bookID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			server.NotFound("subscription-not-found", err, w, r)
+			return
+		}
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	server.RespondOK(map[string]bool{"deleted": true}, w, r)
+}
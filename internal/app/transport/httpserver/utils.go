@@ -9,33 +9,45 @@ import (
 // Deprecated: use auth.ToResponseBook
 func toResponseBook(book domain.Book) models.BookResponse {
 	return models.BookResponse{
-		ID:         book.ID(),
-		Title:      book.Title(),
-		Year:       book.Year(),
-		Author:     book.Author(),
-		Price:      book.Price(),
-		Stock:      book.Stock(),
-		CategoryID: book.CategoryID(),
+		ID:          book.ID(),
+		Title:       book.Title(),
+		Year:        book.Year(),
+		Author:      book.Author(),
+		Price:       book.Price(),
+		Stock:       book.Stock(),
+		CategoryID:  book.CategoryID(),
+		CategoryIDs: book.CategoryIDs(),
+		Archived:    book.Archived(),
 	}
 }
 
 // Deprecated: use auth.ToResponseCategory
 func toResponseCategory(category domain.Category) models.CategoryResponse {
-	return models.CategoryResponse{
-		ID:   category.ID(),
-		Name: category.Name(),
+	response := models.CategoryResponse{
+		ID:           category.ID(),
+		Name:         category.Name(),
+		Slug:         category.Slug(),
+		Description:  category.Description(),
+		DisplayOrder: category.DisplayOrder(),
+		BookCount:    category.BookCount(),
 	}
+	if parentID := category.ParentID(); parentID != 0 {
+		response.ParentID = &parentID
+	}
+
+	return response
 }
 
 // Deprecated: use auth.ToDomainBook
 func toDomainBook(bookRequest models.BookRequest) (domain.Book, error) {
 	return domain.NewBook(domain.NewBookData{
-		Title:      bookRequest.Title,
-		Year:       bookRequest.Year,
-		Author:     bookRequest.Author,
-		Price:      bookRequest.Price,
-		Stock:      bookRequest.Stock,
-		CategoryID: bookRequest.CategoryID,
+		Title:       bookRequest.Title,
+		Year:        bookRequest.Year,
+		Author:      bookRequest.Author,
+		Price:       bookRequest.Price,
+		Stock:       bookRequest.Stock,
+		CategoryID:  bookRequest.CategoryID,
+		CategoryIDs: bookRequest.CategoryIDs,
 	})
 }
 
@@ -0,0 +1,224 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	auth "toptal/internal/app/common/auth"
+	"toptal/internal/app/common/server"
+	"toptal/internal/app/domain"
+	"toptal/internal/app/transport/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetWishlists returns every wishlist of the current user
+func (s HttpServer) GetWishlists(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	wishlists, err := s.wishlistService.GetWishlists(r.Context(), user)
+	if err != nil {
+		server.RespondWithError(err, w, r)
+		return
+	}
+
+	response := make([]models.WishlistResponse, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		response = append(response, auth.ToResponseWishlist(wishlist))
+	}
+
+	server.RespondOK(response, w, r)
+}
+
+// CreateWishlist creates a named wishlist for the current user
+func (s HttpServer) CreateWishlist(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	var wishlistRequest models.WishlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&wishlistRequest); err != nil {
+		server.BadRequest("invalid-json", err, w, r)
+		return
+	}
+
+	wishlist, err := s.wishlistService.CreateWishlist(r.Context(), user, wishlistRequest.Name)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseWishlist(wishlist), w, r)
+}
+
+// GetWishlist returns a wishlist of the current user by ID
+func (s HttpServer) GetWishlist(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	wishlistID, err := strconv.Atoi(chi.URLParam(r, "wishlist_id"))
+	if err != nil {
+		server.BadRequest("invalid-wishlist-id", err, w, r)
+		return
+	}
+
+	wishlist, err := s.wishlistService.GetWishlist(r.Context(), user, wishlistID)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseWishlist(wishlist), w, r)
+}
+
+// RenameWishlist changes the name of a wishlist
+func (s HttpServer) RenameWishlist(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	wishlistID, err := strconv.Atoi(chi.URLParam(r, "wishlist_id"))
+	if err != nil {
+		server.BadRequest("invalid-wishlist-id", err, w, r)
+		return
+	}
+
+	var wishlistRequest models.WishlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&wishlistRequest); err != nil {
+		server.BadRequest("invalid-json", err, w, r)
+		return
+	}
+
+	wishlist, err := s.wishlistService.RenameWishlist(r.Context(), user, wishlistID, wishlistRequest.Name)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseWishlist(wishlist), w, r)
+}
+
+// DeleteWishlist deletes a wishlist with all of its books
+func (s HttpServer) DeleteWishlist(w http.ResponseWriter, r *http.Request) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return
+	}
+
+	wishlistID, err := strconv.Atoi(chi.URLParam(r, "wishlist_id"))
+	if err != nil {
+		server.BadRequest("invalid-wishlist-id", err, w, r)
+		return
+	}
+
+	err = s.wishlistService.DeleteWishlist(r.Context(), user, wishlistID)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(map[string]bool{"deleted": true}, w, r)
+}
+
+// AddWishlistItem puts a book into a wishlist, adding a book twice is a no-op
+func (s HttpServer) AddWishlistItem(w http.ResponseWriter, r *http.Request) {
+	user, wishlistID, bookID, ok := wishlistItemParams(w, r)
+	if !ok {
+		return
+	}
+
+	wishlist, err := s.wishlistService.AddBook(r.Context(), user, wishlistID, bookID)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseWishlist(wishlist), w, r)
+}
+
+// RemoveWishlistItem takes a book out of a wishlist
+func (s HttpServer) RemoveWishlistItem(w http.ResponseWriter, r *http.Request) {
+	user, wishlistID, bookID, ok := wishlistItemParams(w, r)
+	if !ok {
+		return
+	}
+
+	wishlist, err := s.wishlistService.RemoveBook(r.Context(), user, wishlistID, bookID)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	server.RespondOK(auth.ToResponseWishlist(wishlist), w, r)
+}
+
+// MoveWishlistItemToCart reserves a book from a wishlist in the cart and takes it out of the wishlist
+func (s HttpServer) MoveWishlistItemToCart(w http.ResponseWriter, r *http.Request) {
+	user, wishlistID, bookID, ok := wishlistItemParams(w, r)
+	if !ok {
+		return
+	}
+
+	wishlist, items, err := s.wishlistService.MoveToCart(r.Context(), user, wishlistID, bookID)
+	if err != nil {
+		respondWithWishlistError(err, w, r)
+		return
+	}
+
+	response := models.WishlistMoveResponse{
+		Wishlist: auth.ToResponseWishlist(wishlist),
+		Cart:     auth.ToResponseCartItems(items),
+	}
+
+	server.RespondOK(response, w, r)
+}
+
+func wishlistItemParams(w http.ResponseWriter, r *http.Request) (domain.User, int, int, bool) {
+	user, err := getUserFromContext(r.Context())
+	if err != nil {
+		server.BadRequest("invalid-user", err, w, r)
+		return domain.User{}, 0, 0, false
+	}
+
+	wishlistID, err := strconv.Atoi(chi.URLParam(r, "wishlist_id"))
+	if err != nil {
+		server.BadRequest("invalid-wishlist-id", err, w, r)
+		return domain.User{}, 0, 0, false
+	}
+
+	bookID, err := strconv.Atoi(chi.URLParam(r, "book_id"))
+	if err != nil {
+		server.BadRequest("invalid-book-id", err, w, r)
+		return domain.User{}, 0, 0, false
+	}
+
+	return user, wishlistID, bookID, true
+}
+
+func respondWithWishlistError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("wishlist-not-found", err, w, r)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("missing-wishlist-name", err, w, r)
+	case errors.Is(err, domain.ErrInvalidWishlistName):
+		server.BadRequest("invalid-wishlist-name", err, w, r)
+	case errors.Is(err, domain.ErrNegative):
+		server.BadRequest("invalid-book-id", err, w, r)
+	default:
+		server.RespondWithError(err, w, r)
+	}
+}
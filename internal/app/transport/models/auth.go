@@ -5,6 +5,15 @@ import "strings"
 type AuthRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// CartToken is the guest cart to merge into the user's cart on sign in
+	CartToken string `json:"cart_token,omitempty"`
+}
+
+type SignInResponse struct {
+	Token          string `json:"token"`
+	DroppedBookIDs []int  `json:"dropped_book_ids,omitempty"`
+	// CartMergeFailed tells that the guest cart couldn't be merged, it stays with the cart token
+	CartMergeFailed bool `json:"cart_merge_failed,omitempty"`
 }
 
 func (a *AuthRequest) Normalize() {
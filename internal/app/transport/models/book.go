@@ -7,6 +7,8 @@ type BookRequest struct {
 	Price      int    `json:"price"`
 	Stock      int    `json:"stock"`
 	CategoryID int    `json:"category_id"`
+	// CategoryIDs are all the categories of the book, the primary CategoryID is added when missing
+	CategoryIDs []int `json:"category_ids"`
 }
 
 type BookResponse struct {
@@ -17,4 +19,38 @@ type BookResponse struct {
 	Price      int    `json:"price"`
 	Stock      int    `json:"stock"`
 	CategoryID int    `json:"category_id"`
+	// CategoryIDs are all the categories of the book, the primary one included
+	CategoryIDs []int `json:"category_ids"`
+	// Archived books lost their category to a deletion, they are hidden from the listings and can't be reserved
+	Archived bool `json:"archived"`
+}
+
+type BookSuggestionResponse struct {
+	Text  string  `json:"text"`
+	Kind  string  `json:"kind"`
+	Score float64 `json:"score"`
+}
+
+type BookFacetsResponse struct {
+	Categories []CategoryFacetResponse `json:"categories"`
+	Decades    []DecadeFacetResponse   `json:"decades"`
+	Prices     []PriceFacetResponse    `json:"prices"`
+}
+
+type CategoryFacetResponse struct {
+	CategoryID int    `json:"category_id"`
+	Name       string `json:"name"`
+	Count      int    `json:"count"`
+}
+
+type DecadeFacetResponse struct {
+	Decade int `json:"decade"`
+	Count  int `json:"count"`
+}
+
+type PriceFacetResponse struct {
+	MinPrice int `json:"min_price"`
+	// MaxPrice is omitted for the last bucket that has no upper bound
+	MaxPrice int `json:"max_price,omitempty"`
+	Count    int `json:"count"`
 }
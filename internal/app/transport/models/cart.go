@@ -1,9 +1,35 @@
 package models
 
+import "time"
+
+// CartRequest replaces the cart. Every book in BookIDs is one copy,
+// Items set the quantity per book and take precedence.
 type CartRequest struct {
-	BookIDs []int `json:"book_ids"`
+	BookIDs []int             `json:"book_ids"`
+	Items   []CartItemRequest `json:"items,omitempty"`
+}
+
+type CartItemRequest struct {
+	BookID   int `json:"book_id"`
+	Quantity int `json:"quantity"`
+}
+
+// CartQuantityRequest is the optional body of PUT /cart/items/{book_id}, one copy is reserved without it.
+type CartQuantityRequest struct {
+	Quantity *int `json:"quantity"`
 }
 
 type CartResponse struct {
-	BookIDs []int `json:"book_ids"`
+	CartToken string             `json:"cart_token,omitempty"`
+	BookIDs   []int              `json:"book_ids"`
+	Items     []CartItemResponse `json:"items,omitempty"`
+}
+
+type CartItemResponse struct {
+	BookID     int       `json:"book_id"`
+	Title      string    `json:"title"`
+	Price      int       `json:"price"`
+	Quantity   int       `json:"quantity"`
+	ReservedAt time.Time `json:"reserved_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
 }
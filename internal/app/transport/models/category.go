@@ -2,8 +2,36 @@ package models
 
 type CategoryRequest struct {
 	Name string `json:"name"`
+	// ParentID nests the category, zero makes it a root and leaving it out keeps the parent when updating
+	ParentID *int `json:"parent_id"`
+	// Slug is generated from the name when it's empty, leaving it out keeps the slug when updating
+	Slug *string `json:"slug"`
+	// Description and DisplayOrder are kept when left out of an update
+	Description  *string `json:"description"`
+	DisplayOrder *int    `json:"display_order"`
 }
 type CategoryResponse struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
+	// ParentID is null for the root categories
+	ParentID     *int   `json:"parent_id"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	DisplayOrder int    `json:"display_order"`
+	// BookCount is the number of books in stock in the category
+	BookCount int `json:"book_count"`
+}
+
+type DeleteCategoryResponse struct {
+	Deleted bool   `json:"deleted"`
+	Policy  string `json:"policy"`
+	// AffectedBooks is the number of books that were reassigned or archived
+	AffectedBooks int `json:"affected_books"`
+}
+
+type CategoryTreeResponse struct {
+	ID       int                    `json:"id"`
+	Name     string                 `json:"name"`
+	Slug     string                 `json:"slug"`
+	Children []CategoryTreeResponse `json:"children"`
 }
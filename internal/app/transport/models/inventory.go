@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+type RestockRequest struct {
+	Quantity int    `json:"quantity"`
+	Reason   string `json:"reason"`
+}
+
+type InventoryMovementResponse struct {
+	ID        int       `json:"id"`
+	Kind      string    `json:"kind"`
+	Quantity  int       `json:"quantity"`
+	Reason    string    `json:"reason"`
+	ActorID   int       `json:"actor_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type InventoryResponse struct {
+	BookID      int                         `json:"book_id"`
+	Stock       int                         `json:"stock"`
+	LedgerStock int                         `json:"ledger_stock"`
+	Consistent  bool                        `json:"consistent"`
+	Movements   []InventoryMovementResponse `json:"movements"`
+}
+
+type StockReconciliationResponse struct {
+	BookID     int    `json:"book_id"`
+	Title      string `json:"title"`
+	Stock      int    `json:"stock"`
+	Expected   int    `json:"expected"`
+	Difference int    `json:"difference"`
+	Restocked  int    `json:"restocked"`
+	Reserved   int    `json:"reserved"`
+	Sold       int    `json:"sold"`
+	Fixed      bool   `json:"fixed"`
+}
+
+type StockReconciliationReportResponse struct {
+	Checked    int                           `json:"checked"`
+	Mismatches []StockReconciliationResponse `json:"mismatches"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+type OrderItemResponse struct {
+	BookID   int    `json:"book_id"`
+	Title    string `json:"title"`
+	Price    int    `json:"price"`
+	Quantity int    `json:"quantity"`
+}
+
+type OrderResponse struct {
+	ID      int                       `json:"id"`
+	UserID  int                       `json:"user_id"`
+	Items   []OrderItemResponse       `json:"items"`
+	Total   int                       `json:"total"`
+	Status  string                    `json:"status"`
+	History []OrderTransitionResponse `json:"history,omitempty"`
+	// RefundedAt is missing until the money of a cancelled or refunded order is returned
+	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type OrderTransitionResponse struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	ActorID   int       `json:"actor_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrderStatusRequest struct {
+	Status string `json:"status"`
+}
@@ -0,0 +1,8 @@
+package models
+
+import "time"
+
+type SubscriptionResponse struct {
+	BookID    int       `json:"book_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type WishlistRequest struct {
+	Name string `json:"name"`
+}
+
+type WishlistItemResponse struct {
+	BookID  int       `json:"book_id"`
+	Title   string    `json:"title"`
+	Price   int       `json:"price"`
+	InStock bool      `json:"in_stock"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+type WishlistResponse struct {
+	ID        int                    `json:"id"`
+	Name      string                 `json:"name"`
+	Items     []WishlistItemResponse `json:"items"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+type WishlistMoveResponse struct {
+	Wishlist WishlistResponse `json:"wishlist"`
+	Cart     CartResponse     `json:"cart"`
+}
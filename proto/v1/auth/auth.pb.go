@@ -23,9 +23,11 @@ const (
 )
 
 type SignInRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// the guest cart to merge into the user's cart
+	CartToken     string `protobuf:"bytes,3,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,11 +76,22 @@ func (x *SignInRequest) GetPassword() string {
 	return ""
 }
 
+func (x *SignInRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
 type SignInResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// the books of the guest cart that were out of stock and were not merged
+	DroppedBookIds []int64 `protobuf:"varint,2,rep,packed,name=dropped_book_ids,json=droppedBookIds,proto3" json:"dropped_book_ids,omitempty"`
+	// the guest cart couldn't be merged, it stays with the cart token
+	CartMergeFailed bool `protobuf:"varint,3,opt,name=cart_merge_failed,json=cartMergeFailed,proto3" json:"cart_merge_failed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SignInResponse) Reset() {
@@ -118,6 +131,20 @@ func (x *SignInResponse) GetToken() string {
 	return ""
 }
 
+func (x *SignInResponse) GetDroppedBookIds() []int64 {
+	if x != nil {
+		return x.DroppedBookIds
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetCartMergeFailed() bool {
+	if x != nil {
+		return x.CartMergeFailed
+	}
+	return false
+}
+
 type SignUpRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -218,12 +245,16 @@ var File_proto_v1_auth_auth_proto protoreflect.FileDescriptor
 
 const file_proto_v1_auth_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/v1/auth/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"A\n" +
+	"\x18proto/v1/auth/auth.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"`\n" +
 	"\rSignInRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"&\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x03 \x01(\tR\tcartToken\"|\n" +
 	"\x0eSignInResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"A\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12(\n" +
+	"\x10dropped_book_ids\x18\x02 \x03(\x03R\x0edroppedBookIds\x12*\n" +
+	"\x11cart_merge_failed\x18\x03 \x01(\bR\x0fcartMergeFailed\"A\n" +
 	"\rSignUpRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"*\n" +
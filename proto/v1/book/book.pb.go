@@ -23,13 +23,18 @@ const (
 )
 
 type BookData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Price         int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Title  string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Year   int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Author string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Price  int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock  int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	// The primary category, it's always one of the category_ids
+	CategoryId int32 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// All the categories of the book, the primary one is added when missing
+	CategoryIds []int32 `protobuf:"varint,7,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	// Read only, archived books lost their category to a deletion and can't be reserved
+	Archived      bool `protobuf:"varint,8,opt,name=archived,proto3" json:"archived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +111,20 @@ func (x *BookData) GetCategoryId() int32 {
 	return 0
 }
 
+func (x *BookData) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *BookData) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
 type CreateBookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Book          *BookData              `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
@@ -491,11 +510,37 @@ func (x *DeleteBookResponse) GetSuccess() bool {
 }
 
 type ListBooksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    []int32                `protobuf:"varint,1,rep,packed,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The books belonging to any of the categories, primary or not
+	CategoryId []int32 `protobuf:"varint,1,rep,packed,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Deprecated: numbered pages shift when books are hidden or added, use cursor
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Full-text search over titles and authors, the books are ranked by relevance
+	Q string `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
+	// The whole author ignoring the case
+	Author string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	// A part of the author ignoring the case
+	AuthorContains string `protobuf:"bytes,5,opt,name=author_contains,json=authorContains,proto3" json:"author_contains,omitempty"`
+	// Inclusive bounds, zero leaves a bound open
+	YearMin  int32 `protobuf:"varint,6,opt,name=year_min,json=yearMin,proto3" json:"year_min,omitempty"`
+	YearMax  int32 `protobuf:"varint,7,opt,name=year_max,json=yearMax,proto3" json:"year_max,omitempty"`
+	PriceMin int32 `protobuf:"varint,8,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
+	PriceMax int32 `protobuf:"varint,9,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	// Lists the sold out books too, admin only
+	IncludeSoldOut bool `protobuf:"varint,10,opt,name=include_sold_out,json=includeSoldOut,proto3" json:"include_sold_out,omitempty"`
+	// id, price_asc, price_desc, year_asc, year_desc, title, newest or relevance,
+	// relevance when searching and id otherwise by default
+	Sort string `protobuf:"bytes,11,opt,name=sort,proto3" json:"sort,omitempty"`
+	// From 1 to 100, 10 when not set
+	PageSize int32 `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_cursor of the previous page, the first page when empty
+	Cursor string `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Count all books matching the filter
+	WithTotal bool `protobuf:"varint,14,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
+	// The books of all the subcategories of category_id match too
+	IncludeSubcategories bool `protobuf:"varint,15,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ListBooksRequest) Reset() {
@@ -542,9 +587,104 @@ func (x *ListBooksRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *ListBooksRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListBooksRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListBooksRequest) GetAuthorContains() string {
+	if x != nil {
+		return x.AuthorContains
+	}
+	return ""
+}
+
+func (x *ListBooksRequest) GetYearMin() int32 {
+	if x != nil {
+		return x.YearMin
+	}
+	return 0
+}
+
+func (x *ListBooksRequest) GetYearMax() int32 {
+	if x != nil {
+		return x.YearMax
+	}
+	return 0
+}
+
+func (x *ListBooksRequest) GetPriceMin() int32 {
+	if x != nil {
+		return x.PriceMin
+	}
+	return 0
+}
+
+func (x *ListBooksRequest) GetPriceMax() int32 {
+	if x != nil {
+		return x.PriceMax
+	}
+	return 0
+}
+
+func (x *ListBooksRequest) GetIncludeSoldOut() bool {
+	if x != nil {
+		return x.IncludeSoldOut
+	}
+	return false
+}
+
+func (x *ListBooksRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListBooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBooksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBooksRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListBooksRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type ListBooksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Books         []*CreateBookResponse  `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Books []*CreateBookResponse  `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	// Empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Set when with_total was asked for
+	TotalCount    *int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -586,11 +726,535 @@ func (x *ListBooksResponse) GetBooks() []*CreateBookResponse {
 	return nil
 }
 
+func (x *ListBooksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListBooksResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type SuggestBooksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Prefix string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// From 1 to 20, 10 when not set
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestBooksRequest) Reset() {
+	*x = SuggestBooksRequest{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestBooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestBooksRequest) ProtoMessage() {}
+
+func (x *SuggestBooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestBooksRequest.ProtoReflect.Descriptor instead.
+func (*SuggestBooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SuggestBooksRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestBooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BookSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// title or author
+	Kind          string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Score         float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookSuggestion) Reset() {
+	*x = BookSuggestion{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSuggestion) ProtoMessage() {}
+
+func (x *BookSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookSuggestion.ProtoReflect.Descriptor instead.
+func (*BookSuggestion) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookSuggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BookSuggestion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BookSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SuggestBooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*BookSuggestion      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestBooksResponse) Reset() {
+	*x = SuggestBooksResponse{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestBooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestBooksResponse) ProtoMessage() {}
+
+func (x *SuggestBooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestBooksResponse.ProtoReflect.Descriptor instead.
+func (*SuggestBooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SuggestBooksResponse) GetSuggestions() []*BookSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type GetBookFacetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The filter of ListBooks, the counts match the books it lists
+	CategoryId     []int32 `protobuf:"varint,1,rep,packed,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Q              string  `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	Author         string  `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorContains string  `protobuf:"bytes,4,opt,name=author_contains,json=authorContains,proto3" json:"author_contains,omitempty"`
+	YearMin        int32   `protobuf:"varint,5,opt,name=year_min,json=yearMin,proto3" json:"year_min,omitempty"`
+	YearMax        int32   `protobuf:"varint,6,opt,name=year_max,json=yearMax,proto3" json:"year_max,omitempty"`
+	PriceMin       int32   `protobuf:"varint,7,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`
+	PriceMax       int32   `protobuf:"varint,8,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	// Counts the sold out books too, admin only
+	IncludeSoldOut       bool `protobuf:"varint,9,opt,name=include_sold_out,json=includeSoldOut,proto3" json:"include_sold_out,omitempty"`
+	IncludeSubcategories bool `protobuf:"varint,10,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetBookFacetsRequest) Reset() {
+	*x = GetBookFacetsRequest{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookFacetsRequest) ProtoMessage() {}
+
+func (x *GetBookFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBookFacetsRequest) GetCategoryId() []int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return nil
+}
+
+func (x *GetBookFacetsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *GetBookFacetsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetBookFacetsRequest) GetAuthorContains() string {
+	if x != nil {
+		return x.AuthorContains
+	}
+	return ""
+}
+
+func (x *GetBookFacetsRequest) GetYearMin() int32 {
+	if x != nil {
+		return x.YearMin
+	}
+	return 0
+}
+
+func (x *GetBookFacetsRequest) GetYearMax() int32 {
+	if x != nil {
+		return x.YearMax
+	}
+	return 0
+}
+
+func (x *GetBookFacetsRequest) GetPriceMin() int32 {
+	if x != nil {
+		return x.PriceMin
+	}
+	return 0
+}
+
+func (x *GetBookFacetsRequest) GetPriceMax() int32 {
+	if x != nil {
+		return x.PriceMax
+	}
+	return 0
+}
+
+func (x *GetBookFacetsRequest) GetIncludeSoldOut() bool {
+	if x != nil {
+		return x.IncludeSoldOut
+	}
+	return false
+}
+
+func (x *GetBookFacetsRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CategoryFacet) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type DecadeFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The first year of the decade, 1990 for the 1990s
+	Decade        int32 `protobuf:"varint,1,opt,name=decade,proto3" json:"decade,omitempty"`
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecadeFacet) Reset() {
+	*x = DecadeFacet{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecadeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecadeFacet) ProtoMessage() {}
+
+func (x *DecadeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecadeFacet.ProtoReflect.Descriptor instead.
+func (*DecadeFacet) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DecadeFacet) GetDecade() int32 {
+	if x != nil {
+		return x.Decade
+	}
+	return 0
+}
+
+func (x *DecadeFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceFacet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MinPrice int32                  `protobuf:"varint,1,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	// Zero for the last bucket that has no upper bound
+	MaxPrice      int32 `protobuf:"varint,2,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Count         int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceFacet) Reset() {
+	*x = PriceFacet{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceFacet) ProtoMessage() {}
+
+func (x *PriceFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceFacet.ProtoReflect.Descriptor instead.
+func (*PriceFacet) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PriceFacet) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *PriceFacet) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *PriceFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetBookFacetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryFacet       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Decades       []*DecadeFacet         `protobuf:"bytes,2,rep,name=decades,proto3" json:"decades,omitempty"`
+	Prices        []*PriceFacet          `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookFacetsResponse) Reset() {
+	*x = GetBookFacetsResponse{}
+	mi := &file_proto_v1_book_book_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookFacetsResponse) ProtoMessage() {}
+
+func (x *GetBookFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_book_book_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_book_book_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBookFacetsResponse) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetBookFacetsResponse) GetDecades() []*DecadeFacet {
+	if x != nil {
+		return x.Decades
+	}
+	return nil
+}
+
+func (x *GetBookFacetsResponse) GetPrices() []*PriceFacet {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
 var File_proto_v1_book_book_proto protoreflect.FileDescriptor
 
 const file_proto_v1_book_book_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/v1/book/book.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\x99\x01\n" +
+	"\x18proto/v1/book/book.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\xd8\x01\n" +
 	"\bBookData\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x16\n" +
@@ -598,7 +1262,9 @@ const file_proto_v1_book_book_proto_rawDesc = "" +
 	"\x05price\x18\x04 \x01(\x05R\x05price\x12\x14\n" +
 	"\x05stock\x18\x05 \x01(\x05R\x05stock\x12\x1f\n" +
 	"\vcategory_id\x18\x06 \x01(\x05R\n" +
-	"categoryId\"5\n" +
+	"categoryId\x12!\n" +
+	"\fcategory_ids\x18\a \x03(\x05R\vcategoryIds\x12\x1a\n" +
+	"\barchived\x18\b \x01(\bR\barchived\"5\n" +
 	"\x11CreateBookRequest\x12 \n" +
 	"\x04book\x18\x01 \x01(\v2\f.v1.BookDataR\x04book\"F\n" +
 	"\x12CreateBookResponse\x12\x0e\n" +
@@ -618,13 +1284,74 @@ const file_proto_v1_book_book_proto_rawDesc = "" +
 	"\x11DeleteBookRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
 	"\x12DeleteBookResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcd\x03\n" +
 	"\x10ListBooksRequest\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x03(\x05R\n" +
 	"categoryId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\"A\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\f\n" +
+	"\x01q\x18\x03 \x01(\tR\x01q\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12'\n" +
+	"\x0fauthor_contains\x18\x05 \x01(\tR\x0eauthorContains\x12\x19\n" +
+	"\byear_min\x18\x06 \x01(\x05R\ayearMin\x12\x19\n" +
+	"\byear_max\x18\a \x01(\x05R\ayearMax\x12\x1b\n" +
+	"\tprice_min\x18\b \x01(\x05R\bpriceMin\x12\x1b\n" +
+	"\tprice_max\x18\t \x01(\x05R\bpriceMax\x12(\n" +
+	"\x10include_sold_out\x18\n" +
+	" \x01(\bR\x0eincludeSoldOut\x12\x12\n" +
+	"\x04sort\x18\v \x01(\tR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\f \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\r \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x0e \x01(\bR\twithTotal\x123\n" +
+	"\x15include_subcategories\x18\x0f \x01(\bR\x14includeSubcategories\"\x98\x01\n" +
 	"\x11ListBooksResponse\x12,\n" +
-	"\x05books\x18\x01 \x03(\v2\x16.v1.CreateBookResponseR\x05books2\xa2\x03\n" +
+	"\x05books\x18\x01 \x03(\v2\x16.v1.CreateBookResponseR\x05books\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"C\n" +
+	"\x13SuggestBooksRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"N\n" +
+	"\x0eBookSuggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"L\n" +
+	"\x14SuggestBooksResponse\x124\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x12.v1.BookSuggestionR\vsuggestions\"\xd5\x02\n" +
+	"\x14GetBookFacetsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x03(\x05R\n" +
+	"categoryId\x12\f\n" +
+	"\x01q\x18\x02 \x01(\tR\x01q\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12'\n" +
+	"\x0fauthor_contains\x18\x04 \x01(\tR\x0eauthorContains\x12\x19\n" +
+	"\byear_min\x18\x05 \x01(\x05R\ayearMin\x12\x19\n" +
+	"\byear_max\x18\x06 \x01(\x05R\ayearMax\x12\x1b\n" +
+	"\tprice_min\x18\a \x01(\x05R\bpriceMin\x12\x1b\n" +
+	"\tprice_max\x18\b \x01(\x05R\bpriceMax\x12(\n" +
+	"\x10include_sold_out\x18\t \x01(\bR\x0eincludeSoldOut\x123\n" +
+	"\x15include_subcategories\x18\n" +
+	" \x01(\bR\x14includeSubcategories\"Z\n" +
+	"\rCategoryFacet\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\";\n" +
+	"\vDecadeFacet\x12\x16\n" +
+	"\x06decade\x18\x01 \x01(\x05R\x06decade\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\\\n" +
+	"\n" +
+	"PriceFacet\x12\x1b\n" +
+	"\tmin_price\x18\x01 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x02 \x01(\x05R\bmaxPrice\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x9d\x01\n" +
+	"\x15GetBookFacetsResponse\x121\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x11.v1.CategoryFacetR\n" +
+	"categories\x12)\n" +
+	"\adecades\x18\x02 \x03(\v2\x0f.v1.DecadeFacetR\adecades\x12&\n" +
+	"\x06prices\x18\x03 \x03(\v2\x0e.v1.PriceFacetR\x06prices2\xe0\x04\n" +
 	"\vBookService\x12P\n" +
 	"\n" +
 	"CreateBook\x12\x15.v1.CreateBookRequest\x1a\x16.v1.CreateBookResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/book\x12I\n" +
@@ -633,7 +1360,9 @@ const file_proto_v1_book_book_proto_rawDesc = "" +
 	"UpdateBook\x12\x15.v1.UpdateBookRequest\x1a\x16.v1.UpdateBookResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*2\r/v1/book/{id}\x12R\n" +
 	"\n" +
 	"DeleteBook\x12\x15.v1.DeleteBookRequest\x1a\x16.v1.DeleteBookResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/book/{id}\x12K\n" +
-	"\tListBooks\x12\x14.v1.ListBooksRequest\x1a\x15.v1.ListBooksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/booksB\x17Z\x15proto/v1/book; bookv1b\x06proto3"
+	"\tListBooks\x12\x14.v1.ListBooksRequest\x1a\x15.v1.ListBooksResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/books\x12\\\n" +
+	"\fSuggestBooks\x12\x17.v1.SuggestBooksRequest\x1a\x18.v1.SuggestBooksResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/books/suggest\x12^\n" +
+	"\rGetBookFacets\x12\x18.v1.GetBookFacetsRequest\x1a\x19.v1.GetBookFacetsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/books/facetsB\x1eZ\x1ctoptal/proto/v1/book; bookv1b\x06proto3"
 
 var (
 	file_proto_v1_book_book_proto_rawDescOnce sync.Once
@@ -647,19 +1376,27 @@ func file_proto_v1_book_book_proto_rawDescGZIP() []byte {
 	return file_proto_v1_book_book_proto_rawDescData
 }
 
-var file_proto_v1_book_book_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_v1_book_book_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proto_v1_book_book_proto_goTypes = []any{
-	(*BookData)(nil),           // 0: v1.BookData
-	(*CreateBookRequest)(nil),  // 1: v1.CreateBookRequest
-	(*CreateBookResponse)(nil), // 2: v1.CreateBookResponse
-	(*GetBookRequest)(nil),     // 3: v1.GetBookRequest
-	(*GetBookResponse)(nil),    // 4: v1.GetBookResponse
-	(*UpdateBookRequest)(nil),  // 5: v1.UpdateBookRequest
-	(*UpdateBookResponse)(nil), // 6: v1.UpdateBookResponse
-	(*DeleteBookRequest)(nil),  // 7: v1.DeleteBookRequest
-	(*DeleteBookResponse)(nil), // 8: v1.DeleteBookResponse
-	(*ListBooksRequest)(nil),   // 9: v1.ListBooksRequest
-	(*ListBooksResponse)(nil),  // 10: v1.ListBooksResponse
+	(*BookData)(nil),              // 0: v1.BookData
+	(*CreateBookRequest)(nil),     // 1: v1.CreateBookRequest
+	(*CreateBookResponse)(nil),    // 2: v1.CreateBookResponse
+	(*GetBookRequest)(nil),        // 3: v1.GetBookRequest
+	(*GetBookResponse)(nil),       // 4: v1.GetBookResponse
+	(*UpdateBookRequest)(nil),     // 5: v1.UpdateBookRequest
+	(*UpdateBookResponse)(nil),    // 6: v1.UpdateBookResponse
+	(*DeleteBookRequest)(nil),     // 7: v1.DeleteBookRequest
+	(*DeleteBookResponse)(nil),    // 8: v1.DeleteBookResponse
+	(*ListBooksRequest)(nil),      // 9: v1.ListBooksRequest
+	(*ListBooksResponse)(nil),     // 10: v1.ListBooksResponse
+	(*SuggestBooksRequest)(nil),   // 11: v1.SuggestBooksRequest
+	(*BookSuggestion)(nil),        // 12: v1.BookSuggestion
+	(*SuggestBooksResponse)(nil),  // 13: v1.SuggestBooksResponse
+	(*GetBookFacetsRequest)(nil),  // 14: v1.GetBookFacetsRequest
+	(*CategoryFacet)(nil),         // 15: v1.CategoryFacet
+	(*DecadeFacet)(nil),           // 16: v1.DecadeFacet
+	(*PriceFacet)(nil),            // 17: v1.PriceFacet
+	(*GetBookFacetsResponse)(nil), // 18: v1.GetBookFacetsResponse
 }
 var file_proto_v1_book_book_proto_depIdxs = []int32{
 	0,  // 0: v1.CreateBookRequest.book:type_name -> v1.BookData
@@ -668,21 +1405,29 @@ var file_proto_v1_book_book_proto_depIdxs = []int32{
 	0,  // 3: v1.UpdateBookRequest.book:type_name -> v1.BookData
 	0,  // 4: v1.UpdateBookResponse.book:type_name -> v1.BookData
 	2,  // 5: v1.ListBooksResponse.books:type_name -> v1.CreateBookResponse
-	1,  // 6: v1.BookService.CreateBook:input_type -> v1.CreateBookRequest
-	3,  // 7: v1.BookService.GetBook:input_type -> v1.GetBookRequest
-	5,  // 8: v1.BookService.UpdateBook:input_type -> v1.UpdateBookRequest
-	7,  // 9: v1.BookService.DeleteBook:input_type -> v1.DeleteBookRequest
-	9,  // 10: v1.BookService.ListBooks:input_type -> v1.ListBooksRequest
-	2,  // 11: v1.BookService.CreateBook:output_type -> v1.CreateBookResponse
-	4,  // 12: v1.BookService.GetBook:output_type -> v1.GetBookResponse
-	6,  // 13: v1.BookService.UpdateBook:output_type -> v1.UpdateBookResponse
-	8,  // 14: v1.BookService.DeleteBook:output_type -> v1.DeleteBookResponse
-	10, // 15: v1.BookService.ListBooks:output_type -> v1.ListBooksResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	12, // 6: v1.SuggestBooksResponse.suggestions:type_name -> v1.BookSuggestion
+	15, // 7: v1.GetBookFacetsResponse.categories:type_name -> v1.CategoryFacet
+	16, // 8: v1.GetBookFacetsResponse.decades:type_name -> v1.DecadeFacet
+	17, // 9: v1.GetBookFacetsResponse.prices:type_name -> v1.PriceFacet
+	1,  // 10: v1.BookService.CreateBook:input_type -> v1.CreateBookRequest
+	3,  // 11: v1.BookService.GetBook:input_type -> v1.GetBookRequest
+	5,  // 12: v1.BookService.UpdateBook:input_type -> v1.UpdateBookRequest
+	7,  // 13: v1.BookService.DeleteBook:input_type -> v1.DeleteBookRequest
+	9,  // 14: v1.BookService.ListBooks:input_type -> v1.ListBooksRequest
+	11, // 15: v1.BookService.SuggestBooks:input_type -> v1.SuggestBooksRequest
+	14, // 16: v1.BookService.GetBookFacets:input_type -> v1.GetBookFacetsRequest
+	2,  // 17: v1.BookService.CreateBook:output_type -> v1.CreateBookResponse
+	4,  // 18: v1.BookService.GetBook:output_type -> v1.GetBookResponse
+	6,  // 19: v1.BookService.UpdateBook:output_type -> v1.UpdateBookResponse
+	8,  // 20: v1.BookService.DeleteBook:output_type -> v1.DeleteBookResponse
+	10, // 21: v1.BookService.ListBooks:output_type -> v1.ListBooksResponse
+	13, // 22: v1.BookService.SuggestBooks:output_type -> v1.SuggestBooksResponse
+	18, // 23: v1.BookService.GetBookFacets:output_type -> v1.GetBookFacetsResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_book_book_proto_init() }
@@ -690,13 +1435,14 @@ func file_proto_v1_book_book_proto_init() {
 	if File_proto_v1_book_book_proto != nil {
 		return
 	}
+	file_proto_v1_book_book_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_book_book_proto_rawDesc), len(file_proto_v1_book_book_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookService_CreateBook_FullMethodName = "/v1.BookService/CreateBook"
-	BookService_GetBook_FullMethodName    = "/v1.BookService/GetBook"
-	BookService_UpdateBook_FullMethodName = "/v1.BookService/UpdateBook"
-	BookService_DeleteBook_FullMethodName = "/v1.BookService/DeleteBook"
-	BookService_ListBooks_FullMethodName  = "/v1.BookService/ListBooks"
+	BookService_CreateBook_FullMethodName    = "/v1.BookService/CreateBook"
+	BookService_GetBook_FullMethodName       = "/v1.BookService/GetBook"
+	BookService_UpdateBook_FullMethodName    = "/v1.BookService/UpdateBook"
+	BookService_DeleteBook_FullMethodName    = "/v1.BookService/DeleteBook"
+	BookService_ListBooks_FullMethodName     = "/v1.BookService/ListBooks"
+	BookService_SuggestBooks_FullMethodName  = "/v1.BookService/SuggestBooks"
+	BookService_GetBookFacets_FullMethodName = "/v1.BookService/GetBookFacets"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -35,6 +37,8 @@ type BookServiceClient interface {
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
 	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	SuggestBooks(ctx context.Context, in *SuggestBooksRequest, opts ...grpc.CallOption) (*SuggestBooksResponse, error)
+	GetBookFacets(ctx context.Context, in *GetBookFacetsRequest, opts ...grpc.CallOption) (*GetBookFacetsResponse, error)
 }
 
 type bookServiceClient struct {
@@ -95,6 +99,26 @@ func (c *bookServiceClient) ListBooks(ctx context.Context, in *ListBooksRequest,
 	return out, nil
 }
 
+func (c *bookServiceClient) SuggestBooks(ctx context.Context, in *SuggestBooksRequest, opts ...grpc.CallOption) (*SuggestBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestBooksResponse)
+	err := c.cc.Invoke(ctx, BookService_SuggestBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBookFacets(ctx context.Context, in *GetBookFacetsRequest, opts ...grpc.CallOption) (*GetBookFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookFacetsResponse)
+	err := c.cc.Invoke(ctx, BookService_GetBookFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility.
@@ -104,6 +128,8 @@ type BookServiceServer interface {
 	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
 	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
 	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	SuggestBooks(context.Context, *SuggestBooksRequest) (*SuggestBooksResponse, error)
+	GetBookFacets(context.Context, *GetBookFacetsRequest) (*GetBookFacetsResponse, error)
 	mustEmbedUnimplementedBookServiceServer()
 }
 
@@ -129,6 +155,12 @@ func (UnimplementedBookServiceServer) DeleteBook(context.Context, *DeleteBookReq
 func (UnimplementedBookServiceServer) ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
 }
+func (UnimplementedBookServiceServer) SuggestBooks(context.Context, *SuggestBooksRequest) (*SuggestBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestBooks not implemented")
+}
+func (UnimplementedBookServiceServer) GetBookFacets(context.Context, *GetBookFacetsRequest) (*GetBookFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookFacets not implemented")
+}
 func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
 func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
 
@@ -240,6 +272,42 @@ func _BookService_ListBooks_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_SuggestBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SuggestBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_SuggestBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SuggestBooks(ctx, req.(*SuggestBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBookFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBookFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetBookFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBookFacets(ctx, req.(*GetBookFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +335,14 @@ var BookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBooks",
 			Handler:    _BookService_ListBooks_Handler,
 		},
+		{
+			MethodName: "SuggestBooks",
+			Handler:    _BookService_SuggestBooks_Handler,
+		},
+		{
+			MethodName: "GetBookFacets",
+			Handler:    _BookService_GetBookFacets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/book/book.proto",
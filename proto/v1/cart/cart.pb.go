@@ -10,8 +10,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	order "toptal/proto/v1/order"
 	unsafe "unsafe"
 )
 
@@ -22,9 +24,12 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CartData lists the books of a cart. Every book in book_ids is one copy,
+// items set the quantity per book and take precedence.
 type CartData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookIds       []int64                `protobuf:"varint,1,rep,packed,name=book_ids,json=bookIds,proto3" json:"book_ids,omitempty"`
+	Items         []*CartQuantity        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +71,245 @@ func (x *CartData) GetBookIds() []int64 {
 	return nil
 }
 
+func (x *CartData) GetItems() []*CartQuantity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CartQuantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartQuantity) Reset() {
+	*x = CartQuantity{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartQuantity) ProtoMessage() {}
+
+func (x *CartQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartQuantity.ProtoReflect.Descriptor instead.
+func (*CartQuantity) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartQuantity) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *CartQuantity) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CartItemData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReservedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemData) Reset() {
+	*x = CartItemData{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemData) ProtoMessage() {}
+
+func (x *CartItemData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemData.ProtoReflect.Descriptor instead.
+func (*CartItemData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CartItemData) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *CartItemData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CartItemData) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartItemData) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CartItemData) GetReservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReservedAt
+	}
+	return nil
+}
+
+func (x *CartItemData) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{3}
+}
+
+type GetCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cart          *CartData              `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	Items         []*CartItemData        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartResponse) ProtoMessage() {}
+
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCartResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetCart() *CartData {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetItems() []*CartItemData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type UpdateCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -76,7 +320,7 @@ type UpdateCartRequest struct {
 
 func (x *UpdateCartRequest) Reset() {
 	*x = UpdateCartRequest{}
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[1]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -88,7 +332,7 @@ func (x *UpdateCartRequest) String() string {
 func (*UpdateCartRequest) ProtoMessage() {}
 
 func (x *UpdateCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[1]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,7 +345,7 @@ func (x *UpdateCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateCartRequest) GetUserId() int64 {
@@ -122,13 +366,14 @@ type UpdateCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Cart          *CartData              `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	Items         []*CartItemData        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateCartResponse) Reset() {
 	*x = UpdateCartResponse{}
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[2]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -140,7 +385,7 @@ func (x *UpdateCartResponse) String() string {
 func (*UpdateCartResponse) ProtoMessage() {}
 
 func (x *UpdateCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[2]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +398,7 @@ func (x *UpdateCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateCartResponse) GetUserId() int64 {
@@ -170,6 +415,452 @@ func (x *UpdateCartResponse) GetCart() *CartData {
 	return nil
 }
 
+func (x *UpdateCartResponse) GetItems() []*CartItemData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddCartItemRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BookId int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// one copy is reserved when the quantity is not set
+	Quantity      int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCartItemRequest) Reset() {
+	*x = AddCartItemRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCartItemRequest) ProtoMessage() {}
+
+func (x *AddCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCartItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddCartItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *AddCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemRequest) Reset() {
+	*x = RemoveCartItemRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveCartItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{9}
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClearCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestCartRequest) Reset() {
+	*x = GetGuestCartRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestCartRequest) ProtoMessage() {}
+
+func (x *GetGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*GetGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetGuestCartRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+// AddGuestCartItemRequest starts a new guest cart when cart_token is empty
+type AddGuestCartItemRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CartToken string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	BookId    int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// one copy is reserved when the quantity is not set
+	Quantity      int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGuestCartItemRequest) Reset() {
+	*x = AddGuestCartItemRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGuestCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGuestCartItemRequest) ProtoMessage() {}
+
+func (x *AddGuestCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGuestCartItemRequest.ProtoReflect.Descriptor instead.
+func (*AddGuestCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddGuestCartItemRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *AddGuestCartItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *AddGuestCartItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveGuestCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	BookId        int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGuestCartItemRequest) Reset() {
+	*x = RemoveGuestCartItemRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGuestCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGuestCartItemRequest) ProtoMessage() {}
+
+func (x *RemoveGuestCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGuestCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGuestCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveGuestCartItemRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *RemoveGuestCartItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type ClearGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearGuestCartRequest) Reset() {
+	*x = ClearGuestCartRequest{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearGuestCartRequest) ProtoMessage() {}
+
+func (x *ClearGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ClearGuestCartRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+type GuestCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartToken     string                 `protobuf:"bytes,1,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	Cart          *CartData              `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	Items         []*CartItemData        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartResponse) Reset() {
+	*x = GuestCartResponse{}
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartResponse) ProtoMessage() {}
+
+func (x *GuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartResponse.ProtoReflect.Descriptor instead.
+func (*GuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GuestCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *GuestCartResponse) GetCart() *CartData {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *GuestCartResponse) GetItems() []*CartItemData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type CheckoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -179,7 +870,7 @@ type CheckoutRequest struct {
 
 func (x *CheckoutRequest) Reset() {
 	*x = CheckoutRequest{}
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[3]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +882,7 @@ func (x *CheckoutRequest) String() string {
 func (*CheckoutRequest) ProtoMessage() {}
 
 func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[3]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +895,7 @@ func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
 func (*CheckoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CheckoutRequest) GetUserId() int64 {
@@ -216,14 +907,15 @@ func (x *CheckoutRequest) GetUserId() int64 {
 
 type CheckoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Order         *order.OrderData       `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckoutResponse) Reset() {
 	*x = CheckoutResponse{}
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[4]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +927,7 @@ func (x *CheckoutResponse) String() string {
 func (*CheckoutResponse) ProtoMessage() {}
 
 func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_cart_cart_proto_msgTypes[4]
+	mi := &file_proto_v1_cart_cart_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,37 +940,103 @@ func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckoutResponse.ProtoReflect.Descriptor instead.
 func (*CheckoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_cart_cart_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CheckoutResponse) GetSuccess() bool {
+func (x *CheckoutResponse) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
+}
+
+func (x *CheckoutResponse) GetOrder() *order.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
 }
 
 var File_proto_v1_cart_cart_proto protoreflect.FileDescriptor
 
 const file_proto_v1_cart_cart_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/v1/cart/cart.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"%\n" +
+	"\x18proto/v1/cart/cart.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1aproto/v1/order/order.proto\"M\n" +
 	"\bCartData\x12\x19\n" +
-	"\bbook_ids\x18\x01 \x03(\x03R\abookIds\"N\n" +
+	"\bbook_ids\x18\x01 \x03(\x03R\abookIds\x12&\n" +
+	"\x05items\x18\x02 \x03(\v2\x10.v1.CartQuantityR\x05items\"C\n" +
+	"\fCartQuantity\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xe7\x01\n" +
+	"\fCartItemData\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vreserved_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reservedAt\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\"\x10\n" +
+	"\x0eGetCartRequest\"t\n" +
+	"\x0fGetCartResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\x12&\n" +
+	"\x05items\x18\x03 \x03(\v2\x10.v1.CartItemDataR\x05items\"N\n" +
 	"\x11UpdateCartRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
-	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\"O\n" +
+	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\"w\n" +
 	"\x12UpdateCartResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
-	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\"*\n" +
+	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\x12&\n" +
+	"\x05items\x18\x03 \x03(\v2\x10.v1.CartItemDataR\x05items\"I\n" +
+	"\x12AddCartItemRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"0\n" +
+	"\x15RemoveCartItemRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\"\x12\n" +
+	"\x10ClearCartRequest\"-\n" +
+	"\x11ClearCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x13GetGuestCartRequest\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\"m\n" +
+	"\x17AddGuestCartItemRequest\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\x03R\x06bookId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"T\n" +
+	"\x1aRemoveGuestCartItemRequest\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\x03R\x06bookId\"6\n" +
+	"\x15ClearGuestCartRequest\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\"|\n" +
+	"\x11GuestCartResponse\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x01 \x01(\tR\tcartToken\x12 \n" +
+	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\x12&\n" +
+	"\x05items\x18\x03 \x03(\v2\x10.v1.CartItemDataR\x05items\"*\n" +
 	"\x0fCheckoutRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\",\n" +
-	"\x10CheckoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xaf\x01\n" +
-	"\vCartService\x12P\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"V\n" +
+	"\x10CheckoutResponse\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12#\n" +
+	"\x05order\x18\x03 \x01(\v2\r.v1.OrderDataR\x05orderJ\x04\b\x01\x10\x02R\asuccess2\x86\x04\n" +
+	"\vCartService\x12D\n" +
+	"\aGetCart\x12\x12.v1.GetCartRequest\x1a\x13.v1.GetCartResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/cart\x12P\n" +
 	"\n" +
-	"UpdateCart\x12\x15.v1.UpdateCartRequest\x1a\x16.v1.UpdateCartResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/cart\x12N\n" +
-	"\bCheckout\x12\x13.v1.CheckoutRequest\x1a\x14.v1.CheckoutResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/checkoutB\x17Z\x15proto/v1/cart; cartv1b\x06proto3"
+	"UpdateCart\x12\x15.v1.UpdateCartRequest\x1a\x16.v1.UpdateCartResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/cart\x12_\n" +
+	"\vAddCartItem\x12\x16.v1.AddCartItemRequest\x1a\x13.v1.GetCartResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/cart/items/{book_id}\x12b\n" +
+	"\x0eRemoveCartItem\x12\x19.v1.RemoveCartItemRequest\x1a\x13.v1.GetCartResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/cart/items/{book_id}\x12J\n" +
+	"\tClearCart\x12\x14.v1.ClearCartRequest\x1a\x15.v1.ClearCartResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"*\b/v1/cart\x12N\n" +
+	"\bCheckout\x12\x13.v1.CheckoutRequest\x1a\x14.v1.CheckoutResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/checkout2\xaf\x03\n" +
+	"\x10GuestCartService\x12V\n" +
+	"\fGetGuestCart\x12\x17.v1.GetGuestCartRequest\x1a\x15.v1.GuestCartResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/guest/cart\x12q\n" +
+	"\x10AddGuestCartItem\x12\x1b.v1.AddGuestCartItemRequest\x1a\x15.v1.GuestCartResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/guest/cart/items/{book_id}\x12t\n" +
+	"\x13RemoveGuestCartItem\x12\x1e.v1.RemoveGuestCartItemRequest\x1a\x15.v1.GuestCartResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/guest/cart/items/{book_id}\x12Z\n" +
+	"\x0eClearGuestCart\x12\x19.v1.ClearGuestCartRequest\x1a\x15.v1.ClearCartResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/guest/cartB\x1eZ\x1ctoptal/proto/v1/cart; cartv1b\x06proto3"
 
 var (
 	file_proto_v1_cart_cart_proto_rawDescOnce sync.Once
@@ -292,26 +1050,66 @@ func file_proto_v1_cart_cart_proto_rawDescGZIP() []byte {
 	return file_proto_v1_cart_cart_proto_rawDescData
 }
 
-var file_proto_v1_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_v1_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_v1_cart_cart_proto_goTypes = []any{
-	(*CartData)(nil),           // 0: v1.CartData
-	(*UpdateCartRequest)(nil),  // 1: v1.UpdateCartRequest
-	(*UpdateCartResponse)(nil), // 2: v1.UpdateCartResponse
-	(*CheckoutRequest)(nil),    // 3: v1.CheckoutRequest
-	(*CheckoutResponse)(nil),   // 4: v1.CheckoutResponse
+	(*CartData)(nil),                   // 0: v1.CartData
+	(*CartQuantity)(nil),               // 1: v1.CartQuantity
+	(*CartItemData)(nil),               // 2: v1.CartItemData
+	(*GetCartRequest)(nil),             // 3: v1.GetCartRequest
+	(*GetCartResponse)(nil),            // 4: v1.GetCartResponse
+	(*UpdateCartRequest)(nil),          // 5: v1.UpdateCartRequest
+	(*UpdateCartResponse)(nil),         // 6: v1.UpdateCartResponse
+	(*AddCartItemRequest)(nil),         // 7: v1.AddCartItemRequest
+	(*RemoveCartItemRequest)(nil),      // 8: v1.RemoveCartItemRequest
+	(*ClearCartRequest)(nil),           // 9: v1.ClearCartRequest
+	(*ClearCartResponse)(nil),          // 10: v1.ClearCartResponse
+	(*GetGuestCartRequest)(nil),        // 11: v1.GetGuestCartRequest
+	(*AddGuestCartItemRequest)(nil),    // 12: v1.AddGuestCartItemRequest
+	(*RemoveGuestCartItemRequest)(nil), // 13: v1.RemoveGuestCartItemRequest
+	(*ClearGuestCartRequest)(nil),      // 14: v1.ClearGuestCartRequest
+	(*GuestCartResponse)(nil),          // 15: v1.GuestCartResponse
+	(*CheckoutRequest)(nil),            // 16: v1.CheckoutRequest
+	(*CheckoutResponse)(nil),           // 17: v1.CheckoutResponse
+	(*timestamppb.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+	(*order.OrderData)(nil),            // 19: v1.OrderData
 }
 var file_proto_v1_cart_cart_proto_depIdxs = []int32{
-	0, // 0: v1.UpdateCartRequest.cart:type_name -> v1.CartData
-	0, // 1: v1.UpdateCartResponse.cart:type_name -> v1.CartData
-	1, // 2: v1.CartService.UpdateCart:input_type -> v1.UpdateCartRequest
-	3, // 3: v1.CartService.Checkout:input_type -> v1.CheckoutRequest
-	2, // 4: v1.CartService.UpdateCart:output_type -> v1.UpdateCartResponse
-	4, // 5: v1.CartService.Checkout:output_type -> v1.CheckoutResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: v1.CartData.items:type_name -> v1.CartQuantity
+	18, // 1: v1.CartItemData.expires_at:type_name -> google.protobuf.Timestamp
+	18, // 2: v1.CartItemData.reserved_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: v1.GetCartResponse.cart:type_name -> v1.CartData
+	2,  // 4: v1.GetCartResponse.items:type_name -> v1.CartItemData
+	0,  // 5: v1.UpdateCartRequest.cart:type_name -> v1.CartData
+	0,  // 6: v1.UpdateCartResponse.cart:type_name -> v1.CartData
+	2,  // 7: v1.UpdateCartResponse.items:type_name -> v1.CartItemData
+	0,  // 8: v1.GuestCartResponse.cart:type_name -> v1.CartData
+	2,  // 9: v1.GuestCartResponse.items:type_name -> v1.CartItemData
+	19, // 10: v1.CheckoutResponse.order:type_name -> v1.OrderData
+	3,  // 11: v1.CartService.GetCart:input_type -> v1.GetCartRequest
+	5,  // 12: v1.CartService.UpdateCart:input_type -> v1.UpdateCartRequest
+	7,  // 13: v1.CartService.AddCartItem:input_type -> v1.AddCartItemRequest
+	8,  // 14: v1.CartService.RemoveCartItem:input_type -> v1.RemoveCartItemRequest
+	9,  // 15: v1.CartService.ClearCart:input_type -> v1.ClearCartRequest
+	16, // 16: v1.CartService.Checkout:input_type -> v1.CheckoutRequest
+	11, // 17: v1.GuestCartService.GetGuestCart:input_type -> v1.GetGuestCartRequest
+	12, // 18: v1.GuestCartService.AddGuestCartItem:input_type -> v1.AddGuestCartItemRequest
+	13, // 19: v1.GuestCartService.RemoveGuestCartItem:input_type -> v1.RemoveGuestCartItemRequest
+	14, // 20: v1.GuestCartService.ClearGuestCart:input_type -> v1.ClearGuestCartRequest
+	4,  // 21: v1.CartService.GetCart:output_type -> v1.GetCartResponse
+	6,  // 22: v1.CartService.UpdateCart:output_type -> v1.UpdateCartResponse
+	4,  // 23: v1.CartService.AddCartItem:output_type -> v1.GetCartResponse
+	4,  // 24: v1.CartService.RemoveCartItem:output_type -> v1.GetCartResponse
+	10, // 25: v1.CartService.ClearCart:output_type -> v1.ClearCartResponse
+	17, // 26: v1.CartService.Checkout:output_type -> v1.CheckoutResponse
+	15, // 27: v1.GuestCartService.GetGuestCart:output_type -> v1.GuestCartResponse
+	15, // 28: v1.GuestCartService.AddGuestCartItem:output_type -> v1.GuestCartResponse
+	15, // 29: v1.GuestCartService.RemoveGuestCartItem:output_type -> v1.GuestCartResponse
+	10, // 30: v1.GuestCartService.ClearGuestCart:output_type -> v1.ClearCartResponse
+	21, // [21:31] is the sub-list for method output_type
+	11, // [11:21] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_cart_cart_proto_init() }
@@ -325,9 +1123,9 @@ func file_proto_v1_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_cart_cart_proto_rawDesc), len(file_proto_v1_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   18,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_v1_cart_cart_proto_goTypes,
 		DependencyIndexes: file_proto_v1_cart_cart_proto_depIdxs,
@@ -19,15 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_UpdateCart_FullMethodName = "/v1.CartService/UpdateCart"
-	CartService_Checkout_FullMethodName   = "/v1.CartService/Checkout"
+	CartService_GetCart_FullMethodName        = "/v1.CartService/GetCart"
+	CartService_UpdateCart_FullMethodName     = "/v1.CartService/UpdateCart"
+	CartService_AddCartItem_FullMethodName    = "/v1.CartService/AddCartItem"
+	CartService_RemoveCartItem_FullMethodName = "/v1.CartService/RemoveCartItem"
+	CartService_ClearCart_FullMethodName      = "/v1.CartService/ClearCart"
+	CartService_Checkout_FullMethodName       = "/v1.CartService/Checkout"
 )
 
 // CartServiceClient is the client API for CartService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CartServiceClient interface {
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
 	UpdateCart(ctx context.Context, in *UpdateCartRequest, opts ...grpc.CallOption) (*UpdateCartResponse, error)
+	AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
 	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
 }
 
@@ -39,6 +47,16 @@ func NewCartServiceClient(cc grpc.ClientConnInterface) CartServiceClient {
 	return &cartServiceClient{cc}
 }
 
+func (c *cartServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_GetCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartServiceClient) UpdateCart(ctx context.Context, in *UpdateCartRequest, opts ...grpc.CallOption) (*UpdateCartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCartResponse)
@@ -49,6 +67,36 @@ func (c *cartServiceClient) UpdateCart(ctx context.Context, in *UpdateCartReques
 	return out, nil
 }
 
+func (c *cartServiceClient) AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_AddCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_RemoveCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckoutResponse)
@@ -63,7 +111,11 @@ func (c *cartServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, o
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
 type CartServiceServer interface {
+	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
 	UpdateCart(context.Context, *UpdateCartRequest) (*UpdateCartResponse, error)
+	AddCartItem(context.Context, *AddCartItemRequest) (*GetCartResponse, error)
+	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*GetCartResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
 	Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
@@ -75,9 +127,21 @@ type CartServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedCartServiceServer struct{}
 
+func (UnimplementedCartServiceServer) GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
 func (UnimplementedCartServiceServer) UpdateCart(context.Context, *UpdateCartRequest) (*UpdateCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCart not implemented")
 }
+func (UnimplementedCartServiceServer) AddCartItem(context.Context, *AddCartItemRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCartItem not implemented")
+}
+func (UnimplementedCartServiceServer) RemoveCartItem(context.Context, *RemoveCartItemRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartItem not implemented")
+}
+func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
 func (UnimplementedCartServiceServer) Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
 }
@@ -102,6 +166,24 @@ func RegisterCartServiceServer(s grpc.ServiceRegistrar, srv CartServiceServer) {
 	s.RegisterService(&CartService_ServiceDesc, srv)
 }
 
+func _CartService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CartService_UpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCartRequest)
 	if err := dec(in); err != nil {
@@ -120,6 +202,60 @@ func _CartService_UpdateCart_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_AddCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AddCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_AddCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AddCartItem(ctx, req.(*AddCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemoveCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_RemoveCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, req.(*RemoveCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CartService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckoutRequest)
 	if err := dec(in); err != nil {
@@ -145,10 +281,26 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.CartService",
 	HandlerType: (*CartServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCart",
+			Handler:    _CartService_GetCart_Handler,
+		},
 		{
 			MethodName: "UpdateCart",
 			Handler:    _CartService_UpdateCart_Handler,
 		},
+		{
+			MethodName: "AddCartItem",
+			Handler:    _CartService_AddCartItem_Handler,
+		},
+		{
+			MethodName: "RemoveCartItem",
+			Handler:    _CartService_RemoveCartItem_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _CartService_ClearCart_Handler,
+		},
 		{
 			MethodName: "Checkout",
 			Handler:    _CartService_Checkout_Handler,
@@ -157,3 +309,225 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/cart/cart.proto",
 }
+
+const (
+	GuestCartService_GetGuestCart_FullMethodName        = "/v1.GuestCartService/GetGuestCart"
+	GuestCartService_AddGuestCartItem_FullMethodName    = "/v1.GuestCartService/AddGuestCartItem"
+	GuestCartService_RemoveGuestCartItem_FullMethodName = "/v1.GuestCartService/RemoveGuestCartItem"
+	GuestCartService_ClearGuestCart_FullMethodName      = "/v1.GuestCartService/ClearGuestCart"
+)
+
+// GuestCartServiceClient is the client API for GuestCartService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GuestCartService keeps the carts of anonymous visitors, they are identified by an opaque cart token
+// and merged into the user's cart on sign in.
+type GuestCartServiceClient interface {
+	GetGuestCart(ctx context.Context, in *GetGuestCartRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	AddGuestCartItem(ctx context.Context, in *AddGuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	RemoveGuestCartItem(ctx context.Context, in *RemoveGuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	ClearGuestCart(ctx context.Context, in *ClearGuestCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
+}
+
+type guestCartServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuestCartServiceClient(cc grpc.ClientConnInterface) GuestCartServiceClient {
+	return &guestCartServiceClient{cc}
+}
+
+func (c *guestCartServiceClient) GetGuestCart(ctx context.Context, in *GetGuestCartRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, GuestCartService_GetGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestCartServiceClient) AddGuestCartItem(ctx context.Context, in *AddGuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, GuestCartService_AddGuestCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestCartServiceClient) RemoveGuestCartItem(ctx context.Context, in *RemoveGuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, GuestCartService_RemoveGuestCartItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guestCartServiceClient) ClearGuestCart(ctx context.Context, in *ClearGuestCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearCartResponse)
+	err := c.cc.Invoke(ctx, GuestCartService_ClearGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuestCartServiceServer is the server API for GuestCartService service.
+// All implementations must embed UnimplementedGuestCartServiceServer
+// for forward compatibility.
+//
+// GuestCartService keeps the carts of anonymous visitors, they are identified by an opaque cart token
+// and merged into the user's cart on sign in.
+type GuestCartServiceServer interface {
+	GetGuestCart(context.Context, *GetGuestCartRequest) (*GuestCartResponse, error)
+	AddGuestCartItem(context.Context, *AddGuestCartItemRequest) (*GuestCartResponse, error)
+	RemoveGuestCartItem(context.Context, *RemoveGuestCartItemRequest) (*GuestCartResponse, error)
+	ClearGuestCart(context.Context, *ClearGuestCartRequest) (*ClearCartResponse, error)
+	mustEmbedUnimplementedGuestCartServiceServer()
+}
+
+// UnimplementedGuestCartServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuestCartServiceServer struct{}
+
+func (UnimplementedGuestCartServiceServer) GetGuestCart(context.Context, *GetGuestCartRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestCart not implemented")
+}
+func (UnimplementedGuestCartServiceServer) AddGuestCartItem(context.Context, *AddGuestCartItemRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGuestCartItem not implemented")
+}
+func (UnimplementedGuestCartServiceServer) RemoveGuestCartItem(context.Context, *RemoveGuestCartItemRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGuestCartItem not implemented")
+}
+func (UnimplementedGuestCartServiceServer) ClearGuestCart(context.Context, *ClearGuestCartRequest) (*ClearCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearGuestCart not implemented")
+}
+func (UnimplementedGuestCartServiceServer) mustEmbedUnimplementedGuestCartServiceServer() {}
+func (UnimplementedGuestCartServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGuestCartServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuestCartServiceServer will
+// result in compilation errors.
+type UnsafeGuestCartServiceServer interface {
+	mustEmbedUnimplementedGuestCartServiceServer()
+}
+
+func RegisterGuestCartServiceServer(s grpc.ServiceRegistrar, srv GuestCartServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuestCartServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuestCartService_ServiceDesc, srv)
+}
+
+func _GuestCartService_GetGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestCartServiceServer).GetGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestCartService_GetGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestCartServiceServer).GetGuestCart(ctx, req.(*GetGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestCartService_AddGuestCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGuestCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestCartServiceServer).AddGuestCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestCartService_AddGuestCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestCartServiceServer).AddGuestCartItem(ctx, req.(*AddGuestCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestCartService_RemoveGuestCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGuestCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestCartServiceServer).RemoveGuestCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestCartService_RemoveGuestCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestCartServiceServer).RemoveGuestCartItem(ctx, req.(*RemoveGuestCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuestCartService_ClearGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuestCartServiceServer).ClearGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuestCartService_ClearGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuestCartServiceServer).ClearGuestCart(ctx, req.(*ClearGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuestCartService_ServiceDesc is the grpc.ServiceDesc for GuestCartService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuestCartService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.GuestCartService",
+	HandlerType: (*GuestCartServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGuestCart",
+			Handler:    _GuestCartService_GetGuestCart_Handler,
+		},
+		{
+			MethodName: "AddGuestCartItem",
+			Handler:    _GuestCartService_AddGuestCartItem_Handler,
+		},
+		{
+			MethodName: "RemoveGuestCartItem",
+			Handler:    _GuestCartService_RemoveGuestCartItem_Handler,
+		},
+		{
+			MethodName: "ClearGuestCart",
+			Handler:    _GuestCartService_ClearGuestCart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/cart/cart.proto",
+}
@@ -23,8 +23,17 @@ const (
 )
 
 type CategoryData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Not set for the root categories, leaving it out keeps the parent when updating and zero makes it a root
+	ParentId *int32 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Generated from the name when empty, leaving it out keeps the slug when updating
+	Slug *string `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	// Leaving them out keeps the description and the display order when updating
+	Description  *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DisplayOrder *int32  `protobuf:"varint,5,opt,name=display_order,json=displayOrder,proto3,oneof" json:"display_order,omitempty"`
+	// The number of books in stock in the category, ignored in the requests
+	BookCount     int64 `protobuf:"varint,6,opt,name=book_count,json=bookCount,proto3" json:"book_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +75,41 @@ func (x *CategoryData) GetName() string {
 	return ""
 }
 
+func (x *CategoryData) GetParentId() int32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryData) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CategoryData) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CategoryData) GetDisplayOrder() int32 {
+	if x != nil && x.DisplayOrder != nil {
+		return *x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *CategoryData) GetBookCount() int64 {
+	if x != nil {
+		return x.BookCount
+	}
+	return 0
+}
+
 type CreateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      *CategoryData          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -206,6 +250,50 @@ func (x *GetCategoryRequest) GetId() int64 {
 	return 0
 }
 
+type GetCategoryBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryBySlugRequest) Reset() {
+	*x = GetCategoryBySlugRequest{}
+	mi := &file_proto_v1_category_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryBySlugRequest) ProtoMessage() {}
+
+func (x *GetCategoryBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_category_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type GetCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -216,7 +304,7 @@ type GetCategoryResponse struct {
 
 func (x *GetCategoryResponse) Reset() {
 	*x = GetCategoryResponse{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[4]
+	mi := &file_proto_v1_category_category_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +316,7 @@ func (x *GetCategoryResponse) String() string {
 func (*GetCategoryResponse) ProtoMessage() {}
 
 func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[4]
+	mi := &file_proto_v1_category_category_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +329,7 @@ func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
 func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCategoryResponse) GetId() int64 {
@@ -268,7 +356,7 @@ type UpdateCategoryRequest struct {
 
 func (x *UpdateCategoryRequest) Reset() {
 	*x = UpdateCategoryRequest{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[5]
+	mi := &file_proto_v1_category_category_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +368,7 @@ func (x *UpdateCategoryRequest) String() string {
 func (*UpdateCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[5]
+	mi := &file_proto_v1_category_category_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +381,7 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{5}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateCategoryRequest) GetId() int64 {
@@ -320,7 +408,7 @@ type UpdateCategoryResponse struct {
 
 func (x *UpdateCategoryResponse) Reset() {
 	*x = UpdateCategoryResponse{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[6]
+	mi := &file_proto_v1_category_category_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +420,7 @@ func (x *UpdateCategoryResponse) String() string {
 func (*UpdateCategoryResponse) ProtoMessage() {}
 
 func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[6]
+	mi := &file_proto_v1_category_category_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +433,7 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{6}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateCategoryResponse) GetId() int64 {
@@ -363,15 +451,19 @@ func (x *UpdateCategoryResponse) GetCategory() *CategoryData {
 }
 
 type DeleteCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to the books of the category: refuse (the default) keeps a category that has books,
+	// reassign moves them to reassign_to and archive archives them
+	Policy        string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	ReassignTo    int64  `protobuf:"varint,3,opt,name=reassign_to,json=reassignTo,proto3" json:"reassign_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCategoryRequest) Reset() {
 	*x = DeleteCategoryRequest{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[7]
+	mi := &file_proto_v1_category_category_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +475,7 @@ func (x *DeleteCategoryRequest) String() string {
 func (*DeleteCategoryRequest) ProtoMessage() {}
 
 func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[7]
+	mi := &file_proto_v1_category_category_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +488,7 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{7}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteCategoryRequest) GetId() int64 {
@@ -406,16 +498,34 @@ func (x *DeleteCategoryRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteCategoryRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetReassignTo() int64 {
+	if x != nil {
+		return x.ReassignTo
+	}
+	return 0
+}
+
 type DeleteCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The policy that was applied
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The number of books that were reassigned or archived
+	AffectedBooks int64 `protobuf:"varint,3,opt,name=affected_books,json=affectedBooks,proto3" json:"affected_books,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCategoryResponse) Reset() {
 	*x = DeleteCategoryResponse{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[8]
+	mi := &file_proto_v1_category_category_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +537,7 @@ func (x *DeleteCategoryResponse) String() string {
 func (*DeleteCategoryResponse) ProtoMessage() {}
 
 func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[8]
+	mi := &file_proto_v1_category_category_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +550,7 @@ func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{8}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteCategoryResponse) GetSuccess() bool {
@@ -450,15 +560,35 @@ func (x *DeleteCategoryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *DeleteCategoryResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *DeleteCategoryResponse) GetAffectedBooks() int64 {
+	if x != nil {
+		return x.AffectedBooks
+	}
+	return 0
+}
+
 type ListCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From 1 to 100, 10 when not set
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_cursor of the previous page, the first page when empty
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Count all categories
+	WithTotal     bool `protobuf:"varint,3,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCategoriesRequest) Reset() {
 	*x = ListCategoriesRequest{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[9]
+	mi := &file_proto_v1_category_category_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +600,7 @@ func (x *ListCategoriesRequest) String() string {
 func (*ListCategoriesRequest) ProtoMessage() {}
 
 func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[9]
+	mi := &file_proto_v1_category_category_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,19 +613,44 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{9}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCategoriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
 }
 
 type ListCategoriesResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Categories    []*CreateCategoryResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Categories []*CreateCategoryResponse `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Empty on the last page
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Set when with_total was asked for
+	TotalCount    *int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCategoriesResponse) Reset() {
 	*x = ListCategoriesResponse{}
-	mi := &file_proto_v1_category_category_proto_msgTypes[10]
+	mi := &file_proto_v1_category_category_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +662,7 @@ func (x *ListCategoriesResponse) String() string {
 func (*ListCategoriesResponse) ProtoMessage() {}
 
 func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_category_category_proto_msgTypes[10]
+	mi := &file_proto_v1_category_category_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +675,7 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{10}
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListCategoriesResponse) GetCategories() []*CreateCategoryResponse {
@@ -530,20 +685,187 @@ func (x *ListCategoriesResponse) GetCategories() []*CreateCategoryResponse {
 	return nil
 }
 
+func (x *ListCategoriesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListCategoriesResponse) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_proto_v1_category_category_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_category_category_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{12}
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      *CategoryData          `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_proto_v1_category_category_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_category_category_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryNode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryNode) GetCategory() *CategoryData {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryNode        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_proto_v1_category_category_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_category_category_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_category_category_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCategoryTreeResponse) GetCategories() []*CategoryNode {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
 var File_proto_v1_category_category_proto protoreflect.FileDescriptor
 
 const file_proto_v1_category_category_proto_rawDesc = "" +
 	"\n" +
-	" proto/v1/category/category.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\"\n" +
+	" proto/v1/category/category.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\"\x86\x02\n" +
 	"\fCategoryData\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\x05H\x00R\bparentId\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x03 \x01(\tH\x01R\x04slug\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12(\n" +
+	"\rdisplay_order\x18\x05 \x01(\x05H\x03R\fdisplayOrder\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"book_count\x18\x06 \x01(\x03R\tbookCountB\f\n" +
+	"\n" +
+	"_parent_idB\a\n" +
+	"\x05_slugB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_display_order\"E\n" +
 	"\x15CreateCategoryRequest\x12,\n" +
 	"\bcategory\x18\x01 \x01(\v2\x10.v1.CategoryDataR\bcategory\"V\n" +
 	"\x16CreateCategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
 	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\"$\n" +
 	"\x12GetCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"S\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x18GetCategoryBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"S\n" +
 	"\x13GetCategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
 	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\"U\n" +
@@ -552,22 +874,47 @@ const file_proto_v1_category_category_proto_rawDesc = "" +
 	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\"V\n" +
 	"\x16UpdateCategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
-	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\"'\n" +
+	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\"`\n" +
 	"\x15DeleteCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12\x1f\n" +
+	"\vreassign_to\x18\x03 \x01(\x03R\n" +
+	"reassignTo\"q\n" +
 	"\x16DeleteCategoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x17\n" +
-	"\x15ListCategoriesRequest\"T\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12%\n" +
+	"\x0eaffected_books\x18\x03 \x01(\x03R\raffectedBooks\"k\n" +
+	"\x15ListCategoriesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\x03 \x01(\bR\twithTotal\"\xab\x01\n" +
 	"\x16ListCategoriesResponse\x12:\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1a.v1.CreateCategoryResponseR\n" +
-	"categories2\xfa\x03\n" +
+	"categories\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12$\n" +
+	"\vtotal_count\x18\x03 \x01(\x03H\x00R\n" +
+	"totalCount\x88\x01\x01B\x0e\n" +
+	"\f_total_count\"\x18\n" +
+	"\x16GetCategoryTreeRequest\"z\n" +
+	"\fCategoryNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\bcategory\x18\x02 \x01(\v2\x10.v1.CategoryDataR\bcategory\x12,\n" +
+	"\bchildren\x18\x03 \x03(\v2\x10.v1.CategoryNodeR\bchildren\"K\n" +
+	"\x17GetCategoryTreeResponse\x120\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x10.v1.CategoryNodeR\n" +
+	"categories2\xd4\x05\n" +
 	"\x0fCategoryService\x12`\n" +
 	"\x0eCreateCategory\x12\x19.v1.CreateCategoryRequest\x1a\x1a.v1.CreateCategoryResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/category\x12Y\n" +
-	"\vGetCategory\x12\x16.v1.GetCategoryRequest\x1a\x17.v1.GetCategoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/category/{id}\x12e\n" +
+	"\vGetCategory\x12\x16.v1.GetCategoryRequest\x1a\x17.v1.GetCategoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/category/{id}\x12o\n" +
+	"\x11GetCategoryBySlug\x12\x1c.v1.GetCategoryBySlugRequest\x1a\x17.v1.GetCategoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/category/by-slug/{slug}\x12e\n" +
 	"\x0eUpdateCategory\x12\x19.v1.UpdateCategoryRequest\x1a\x1a.v1.UpdateCategoryResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/category/{id}\x12b\n" +
 	"\x0eDeleteCategory\x12\x19.v1.DeleteCategoryRequest\x1a\x1a.v1.DeleteCategoryResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/category/{id}\x12_\n" +
-	"\x0eListCategories\x12\x19.v1.ListCategoriesRequest\x1a\x1a.v1.ListCategoriesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categoriesB\x1fZ\x1dproto/v1/category; categoryv1b\x06proto3"
+	"\x0eListCategories\x12\x19.v1.ListCategoriesRequest\x1a\x1a.v1.ListCategoriesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12g\n" +
+	"\x0fGetCategoryTree\x12\x1a.v1.GetCategoryTreeRequest\x1a\x1b.v1.GetCategoryTreeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/categories/treeB&Z$toptal/proto/v1/category; categoryv1b\x06proto3"
 
 var (
 	file_proto_v1_category_category_proto_rawDescOnce sync.Once
@@ -581,19 +928,23 @@ func file_proto_v1_category_category_proto_rawDescGZIP() []byte {
 	return file_proto_v1_category_category_proto_rawDescData
 }
 
-var file_proto_v1_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_v1_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_v1_category_category_proto_goTypes = []any{
-	(*CategoryData)(nil),           // 0: v1.CategoryData
-	(*CreateCategoryRequest)(nil),  // 1: v1.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil), // 2: v1.CreateCategoryResponse
-	(*GetCategoryRequest)(nil),     // 3: v1.GetCategoryRequest
-	(*GetCategoryResponse)(nil),    // 4: v1.GetCategoryResponse
-	(*UpdateCategoryRequest)(nil),  // 5: v1.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil), // 6: v1.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),  // 7: v1.DeleteCategoryRequest
-	(*DeleteCategoryResponse)(nil), // 8: v1.DeleteCategoryResponse
-	(*ListCategoriesRequest)(nil),  // 9: v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil), // 10: v1.ListCategoriesResponse
+	(*CategoryData)(nil),             // 0: v1.CategoryData
+	(*CreateCategoryRequest)(nil),    // 1: v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),   // 2: v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),       // 3: v1.GetCategoryRequest
+	(*GetCategoryBySlugRequest)(nil), // 4: v1.GetCategoryBySlugRequest
+	(*GetCategoryResponse)(nil),      // 5: v1.GetCategoryResponse
+	(*UpdateCategoryRequest)(nil),    // 6: v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),   // 7: v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),    // 8: v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),   // 9: v1.DeleteCategoryResponse
+	(*ListCategoriesRequest)(nil),    // 10: v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),   // 11: v1.ListCategoriesResponse
+	(*GetCategoryTreeRequest)(nil),   // 12: v1.GetCategoryTreeRequest
+	(*CategoryNode)(nil),             // 13: v1.CategoryNode
+	(*GetCategoryTreeResponse)(nil),  // 14: v1.GetCategoryTreeResponse
 }
 var file_proto_v1_category_category_proto_depIdxs = []int32{
 	0,  // 0: v1.CreateCategoryRequest.category:type_name -> v1.CategoryData
@@ -602,21 +953,28 @@ var file_proto_v1_category_category_proto_depIdxs = []int32{
 	0,  // 3: v1.UpdateCategoryRequest.category:type_name -> v1.CategoryData
 	0,  // 4: v1.UpdateCategoryResponse.category:type_name -> v1.CategoryData
 	2,  // 5: v1.ListCategoriesResponse.categories:type_name -> v1.CreateCategoryResponse
-	1,  // 6: v1.CategoryService.CreateCategory:input_type -> v1.CreateCategoryRequest
-	3,  // 7: v1.CategoryService.GetCategory:input_type -> v1.GetCategoryRequest
-	5,  // 8: v1.CategoryService.UpdateCategory:input_type -> v1.UpdateCategoryRequest
-	7,  // 9: v1.CategoryService.DeleteCategory:input_type -> v1.DeleteCategoryRequest
-	9,  // 10: v1.CategoryService.ListCategories:input_type -> v1.ListCategoriesRequest
-	2,  // 11: v1.CategoryService.CreateCategory:output_type -> v1.CreateCategoryResponse
-	4,  // 12: v1.CategoryService.GetCategory:output_type -> v1.GetCategoryResponse
-	6,  // 13: v1.CategoryService.UpdateCategory:output_type -> v1.UpdateCategoryResponse
-	8,  // 14: v1.CategoryService.DeleteCategory:output_type -> v1.DeleteCategoryResponse
-	10, // 15: v1.CategoryService.ListCategories:output_type -> v1.ListCategoriesResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 6: v1.CategoryNode.category:type_name -> v1.CategoryData
+	13, // 7: v1.CategoryNode.children:type_name -> v1.CategoryNode
+	13, // 8: v1.GetCategoryTreeResponse.categories:type_name -> v1.CategoryNode
+	1,  // 9: v1.CategoryService.CreateCategory:input_type -> v1.CreateCategoryRequest
+	3,  // 10: v1.CategoryService.GetCategory:input_type -> v1.GetCategoryRequest
+	4,  // 11: v1.CategoryService.GetCategoryBySlug:input_type -> v1.GetCategoryBySlugRequest
+	6,  // 12: v1.CategoryService.UpdateCategory:input_type -> v1.UpdateCategoryRequest
+	8,  // 13: v1.CategoryService.DeleteCategory:input_type -> v1.DeleteCategoryRequest
+	10, // 14: v1.CategoryService.ListCategories:input_type -> v1.ListCategoriesRequest
+	12, // 15: v1.CategoryService.GetCategoryTree:input_type -> v1.GetCategoryTreeRequest
+	2,  // 16: v1.CategoryService.CreateCategory:output_type -> v1.CreateCategoryResponse
+	5,  // 17: v1.CategoryService.GetCategory:output_type -> v1.GetCategoryResponse
+	5,  // 18: v1.CategoryService.GetCategoryBySlug:output_type -> v1.GetCategoryResponse
+	7,  // 19: v1.CategoryService.UpdateCategory:output_type -> v1.UpdateCategoryResponse
+	9,  // 20: v1.CategoryService.DeleteCategory:output_type -> v1.DeleteCategoryResponse
+	11, // 21: v1.CategoryService.ListCategories:output_type -> v1.ListCategoriesResponse
+	14, // 22: v1.CategoryService.GetCategoryTree:output_type -> v1.GetCategoryTreeResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_category_category_proto_init() }
@@ -624,13 +982,15 @@ func file_proto_v1_category_category_proto_init() {
 	if File_proto_v1_category_category_proto != nil {
 		return
 	}
+	file_proto_v1_category_category_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_v1_category_category_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_category_category_proto_rawDesc), len(file_proto_v1_category_category_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: proto/v1/inventory/inventory.proto
+
+package inventoryv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InventoryMovementData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorId       int64                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryMovementData) Reset() {
+	*x = InventoryMovementData{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryMovementData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryMovementData) ProtoMessage() {}
+
+func (x *InventoryMovementData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryMovementData.ProtoReflect.Descriptor instead.
+func (*InventoryMovementData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InventoryMovementData) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InventoryMovementData) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InventoryMovementData) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryMovementData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InventoryMovementData) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *InventoryMovementData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RestockBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockBookRequest) Reset() {
+	*x = RestockBookRequest{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockBookRequest) ProtoMessage() {}
+
+func (x *RestockBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockBookRequest.ProtoReflect.Descriptor instead.
+func (*RestockBookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RestockBookRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *RestockBookRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RestockBookRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestockBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Stock         int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockBookResponse) Reset() {
+	*x = RestockBookResponse{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockBookResponse) ProtoMessage() {}
+
+func (x *RestockBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockBookResponse.ProtoReflect.Descriptor instead.
+func (*RestockBookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RestockBookResponse) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *RestockBookResponse) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type GetBookInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookInventoryRequest) Reset() {
+	*x = GetBookInventoryRequest{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookInventoryRequest) ProtoMessage() {}
+
+func (x *GetBookInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBookInventoryRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type GetBookInventoryResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BookId      int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Stock       int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	LedgerStock int32                  `protobuf:"varint,3,opt,name=ledger_stock,json=ledgerStock,proto3" json:"ledger_stock,omitempty"`
+	Consistent  bool                   `protobuf:"varint,4,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	// The latest movements, newest first
+	Movements     []*InventoryMovementData `protobuf:"bytes,5,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookInventoryResponse) Reset() {
+	*x = GetBookInventoryResponse{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookInventoryResponse) ProtoMessage() {}
+
+func (x *GetBookInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBookInventoryResponse) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *GetBookInventoryResponse) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *GetBookInventoryResponse) GetLedgerStock() int32 {
+	if x != nil {
+		return x.LedgerStock
+	}
+	return 0
+}
+
+func (x *GetBookInventoryResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *GetBookInventoryResponse) GetMovements() []*InventoryMovementData {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type ReconcileStocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Correct the stocks that don't match the expected ones
+	Fix           bool `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileStocksRequest) Reset() {
+	*x = ReconcileStocksRequest{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStocksRequest) ProtoMessage() {}
+
+func (x *ReconcileStocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStocksRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileStocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReconcileStocksRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type StockReconciliationData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Stock         int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	Expected      int32                  `protobuf:"varint,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Difference    int32                  `protobuf:"varint,5,opt,name=difference,proto3" json:"difference,omitempty"`
+	Restocked     int32                  `protobuf:"varint,6,opt,name=restocked,proto3" json:"restocked,omitempty"`
+	Reserved      int32                  `protobuf:"varint,7,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Sold          int32                  `protobuf:"varint,8,opt,name=sold,proto3" json:"sold,omitempty"`
+	Fixed         bool                   `protobuf:"varint,9,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReconciliationData) Reset() {
+	*x = StockReconciliationData{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReconciliationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReconciliationData) ProtoMessage() {}
+
+func (x *StockReconciliationData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReconciliationData.ProtoReflect.Descriptor instead.
+func (*StockReconciliationData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StockReconciliationData) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StockReconciliationData) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetExpected() int32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetDifference() int32 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetRestocked() int32 {
+	if x != nil {
+		return x.Restocked
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *StockReconciliationData) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+type ReconcileStocksResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Checked int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	// The books whose stock didn't match the expected one, including the fixed ones
+	Mismatches    []*StockReconciliationData `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileStocksResponse) Reset() {
+	*x = ReconcileStocksResponse{}
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStocksResponse) ProtoMessage() {}
+
+func (x *ReconcileStocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_inventory_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStocksResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileStocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_inventory_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReconcileStocksResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcileStocksResponse) GetMismatches() []*StockReconciliationData {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+var File_proto_v1_inventory_inventory_proto protoreflect.FileDescriptor
+
+const file_proto_v1_inventory_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/v1/inventory/inventory.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x01\n" +
+	"\x15InventoryMovementData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x12RestockBookRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"D\n" +
+	"\x13RestockBookResponse\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\"2\n" +
+	"\x17GetBookInventoryRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\"\xc5\x01\n" +
+	"\x18GetBookInventoryResponse\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\x12!\n" +
+	"\fledger_stock\x18\x03 \x01(\x05R\vledgerStock\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x04 \x01(\bR\n" +
+	"consistent\x127\n" +
+	"\tmovements\x18\x05 \x03(\v2\x19.v1.InventoryMovementDataR\tmovements\"*\n" +
+	"\x16ReconcileStocksRequest\x12\x10\n" +
+	"\x03fix\x18\x01 \x01(\bR\x03fix\"\xfe\x01\n" +
+	"\x17StockReconciliationData\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05stock\x18\x03 \x01(\x05R\x05stock\x12\x1a\n" +
+	"\bexpected\x18\x04 \x01(\x05R\bexpected\x12\x1e\n" +
+	"\n" +
+	"difference\x18\x05 \x01(\x05R\n" +
+	"difference\x12\x1c\n" +
+	"\trestocked\x18\x06 \x01(\x05R\trestocked\x12\x1a\n" +
+	"\breserved\x18\a \x01(\x05R\breserved\x12\x12\n" +
+	"\x04sold\x18\b \x01(\x05R\x04sold\x12\x14\n" +
+	"\x05fixed\x18\t \x01(\bR\x05fixed\"p\n" +
+	"\x17ReconcileStocksResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12;\n" +
+	"\n" +
+	"mismatches\x18\x02 \x03(\v2\x1b.v1.StockReconciliationDataR\n" +
+	"mismatches2\x84\x03\n" +
+	"\x10InventoryService\x12e\n" +
+	"\vRestockBook\x12\x16.v1.RestockBookRequest\x1a\x17.v1.RestockBookResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/book/{book_id}/restock\x12s\n" +
+	"\x10GetBookInventory\x12\x1b.v1.GetBookInventoryRequest\x1a\x1c.v1.GetBookInventoryResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/book/{book_id}/inventory\x12\x93\x01\n" +
+	"\x0fReconcileStocks\x12\x1a.v1.ReconcileStocksRequest\x1a\x1b.v1.ReconcileStocksResponse\"G\x82\xd3\xe4\x93\x02AZ!:\x01*\"\x1c/v1/inventory/reconciliation\x12\x1c/v1/inventory/reconciliationB(Z&toptal/proto/v1/inventory; inventoryv1b\x06proto3"
+
+var (
+	file_proto_v1_inventory_inventory_proto_rawDescOnce sync.Once
+	file_proto_v1_inventory_inventory_proto_rawDescData []byte
+)
+
+func file_proto_v1_inventory_inventory_proto_rawDescGZIP() []byte {
+	file_proto_v1_inventory_inventory_proto_rawDescOnce.Do(func() {
+		file_proto_v1_inventory_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_inventory_inventory_proto_rawDesc), len(file_proto_v1_inventory_inventory_proto_rawDesc)))
+	})
+	return file_proto_v1_inventory_inventory_proto_rawDescData
+}
+
+var file_proto_v1_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_v1_inventory_inventory_proto_goTypes = []any{
+	(*InventoryMovementData)(nil),    // 0: v1.InventoryMovementData
+	(*RestockBookRequest)(nil),       // 1: v1.RestockBookRequest
+	(*RestockBookResponse)(nil),      // 2: v1.RestockBookResponse
+	(*GetBookInventoryRequest)(nil),  // 3: v1.GetBookInventoryRequest
+	(*GetBookInventoryResponse)(nil), // 4: v1.GetBookInventoryResponse
+	(*ReconcileStocksRequest)(nil),   // 5: v1.ReconcileStocksRequest
+	(*StockReconciliationData)(nil),  // 6: v1.StockReconciliationData
+	(*ReconcileStocksResponse)(nil),  // 7: v1.ReconcileStocksResponse
+	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+}
+var file_proto_v1_inventory_inventory_proto_depIdxs = []int32{
+	8, // 0: v1.InventoryMovementData.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: v1.GetBookInventoryResponse.movements:type_name -> v1.InventoryMovementData
+	6, // 2: v1.ReconcileStocksResponse.mismatches:type_name -> v1.StockReconciliationData
+	1, // 3: v1.InventoryService.RestockBook:input_type -> v1.RestockBookRequest
+	3, // 4: v1.InventoryService.GetBookInventory:input_type -> v1.GetBookInventoryRequest
+	5, // 5: v1.InventoryService.ReconcileStocks:input_type -> v1.ReconcileStocksRequest
+	2, // 6: v1.InventoryService.RestockBook:output_type -> v1.RestockBookResponse
+	4, // 7: v1.InventoryService.GetBookInventory:output_type -> v1.GetBookInventoryResponse
+	7, // 8: v1.InventoryService.ReconcileStocks:output_type -> v1.ReconcileStocksResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_inventory_inventory_proto_init() }
+func file_proto_v1_inventory_inventory_proto_init() {
+	if File_proto_v1_inventory_inventory_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_inventory_inventory_proto_rawDesc), len(file_proto_v1_inventory_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_inventory_inventory_proto_goTypes,
+		DependencyIndexes: file_proto_v1_inventory_inventory_proto_depIdxs,
+		MessageInfos:      file_proto_v1_inventory_inventory_proto_msgTypes,
+	}.Build()
+	File_proto_v1_inventory_inventory_proto = out.File
+	file_proto_v1_inventory_inventory_proto_goTypes = nil
+	file_proto_v1_inventory_inventory_proto_depIdxs = nil
+}
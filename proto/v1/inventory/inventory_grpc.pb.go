@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: proto/v1/inventory/inventory.proto
+
+package inventoryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InventoryService_RestockBook_FullMethodName      = "/v1.InventoryService/RestockBook"
+	InventoryService_GetBookInventory_FullMethodName = "/v1.InventoryService/GetBookInventory"
+	InventoryService_ReconcileStocks_FullMethodName  = "/v1.InventoryService/ReconcileStocks"
+)
+
+// InventoryServiceClient is the client API for InventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The inventory ledger, admin only
+type InventoryServiceClient interface {
+	RestockBook(ctx context.Context, in *RestockBookRequest, opts ...grpc.CallOption) (*RestockBookResponse, error)
+	GetBookInventory(ctx context.Context, in *GetBookInventoryRequest, opts ...grpc.CallOption) (*GetBookInventoryResponse, error)
+	ReconcileStocks(ctx context.Context, in *ReconcileStocksRequest, opts ...grpc.CallOption) (*ReconcileStocksResponse, error)
+}
+
+type inventoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryServiceClient(cc grpc.ClientConnInterface) InventoryServiceClient {
+	return &inventoryServiceClient{cc}
+}
+
+func (c *inventoryServiceClient) RestockBook(ctx context.Context, in *RestockBookRequest, opts ...grpc.CallOption) (*RestockBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestockBookResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestockBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetBookInventory(ctx context.Context, in *GetBookInventoryRequest, opts ...grpc.CallOption) (*GetBookInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookInventoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetBookInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReconcileStocks(ctx context.Context, in *ReconcileStocksRequest, opts ...grpc.CallOption) (*ReconcileStocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileStocksResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReconcileStocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+//
+// The inventory ledger, admin only
+type InventoryServiceServer interface {
+	RestockBook(context.Context, *RestockBookRequest) (*RestockBookResponse, error)
+	GetBookInventory(context.Context, *GetBookInventoryRequest) (*GetBookInventoryResponse, error)
+	ReconcileStocks(context.Context, *ReconcileStocksRequest) (*ReconcileStocksResponse, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) RestockBook(context.Context, *RestockBookRequest) (*RestockBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockBook not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetBookInventory(context.Context, *GetBookInventoryRequest) (*GetBookInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReconcileStocks(context.Context, *ReconcileStocksRequest) (*ReconcileStocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStocks not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_RestockBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestockBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestockBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestockBook(ctx, req.(*RestockBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetBookInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetBookInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetBookInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetBookInventory(ctx, req.(*GetBookInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReconcileStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReconcileStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReconcileStocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReconcileStocks(ctx, req.(*ReconcileStocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.InventoryService",
+	HandlerType: (*InventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RestockBook",
+			Handler:    _InventoryService_RestockBook_Handler,
+		},
+		{
+			MethodName: "GetBookInventory",
+			Handler:    _InventoryService_GetBookInventory_Handler,
+		},
+		{
+			MethodName: "ReconcileStocks",
+			Handler:    _InventoryService_ReconcileStocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/inventory/inventory.proto",
+}
@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: proto/v1/subscription/subscription.proto
+
+package subscriptionv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscriptionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionData) Reset() {
+	*x = SubscriptionData{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionData) ProtoMessage() {}
+
+func (x *SubscriptionData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionData.ProtoReflect.Descriptor instead.
+func (*SubscriptionData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscriptionData) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *SubscriptionData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*SubscriptionData    `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*SubscriptionData {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubscribeRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *SubscriptionData      `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeResponse) GetSubscription() *SubscriptionData {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnsubscribeRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_subscription_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_subscription_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnsubscribeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_proto_v1_subscription_subscription_proto protoreflect.FileDescriptor
+
+const file_proto_v1_subscription_subscription_proto_rawDesc = "" +
+	"\n" +
+	"(proto/v1/subscription/subscription.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"f\n" +
+	"\x10SubscriptionData\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x1a\n" +
+	"\x18ListSubscriptionsRequest\"W\n" +
+	"\x19ListSubscriptionsResponse\x12:\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x14.v1.SubscriptionDataR\rsubscriptions\"+\n" +
+	"\x10SubscribeRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\"M\n" +
+	"\x11SubscribeResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.v1.SubscriptionDataR\fsubscription\"-\n" +
+	"\x12UnsubscribeRequest\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\"/\n" +
+	"\x13UnsubscribeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc6\x02\n" +
+	"\x13SubscriptionService\x12k\n" +
+	"\x11ListSubscriptions\x12\x1c.v1.ListSubscriptionsRequest\x1a\x1d.v1.ListSubscriptionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/subscriptions\x12]\n" +
+	"\tSubscribe\x12\x14.v1.SubscribeRequest\x1a\x15.v1.SubscribeResponse\"#\x82\xd3\xe4\x93\x02\x1d\x1a\x1b/v1/subscriptions/{book_id}\x12c\n" +
+	"\vUnsubscribe\x12\x16.v1.UnsubscribeRequest\x1a\x17.v1.UnsubscribeResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/subscriptions/{book_id}B.Z,toptal/proto/v1/subscription; subscriptionv1b\x06proto3"
+
+var (
+	file_proto_v1_subscription_subscription_proto_rawDescOnce sync.Once
+	file_proto_v1_subscription_subscription_proto_rawDescData []byte
+)
+
+func file_proto_v1_subscription_subscription_proto_rawDescGZIP() []byte {
+	file_proto_v1_subscription_subscription_proto_rawDescOnce.Do(func() {
+		file_proto_v1_subscription_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_subscription_subscription_proto_rawDesc), len(file_proto_v1_subscription_subscription_proto_rawDesc)))
+	})
+	return file_proto_v1_subscription_subscription_proto_rawDescData
+}
+
+var file_proto_v1_subscription_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_v1_subscription_subscription_proto_goTypes = []any{
+	(*SubscriptionData)(nil),          // 0: v1.SubscriptionData
+	(*ListSubscriptionsRequest)(nil),  // 1: v1.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil), // 2: v1.ListSubscriptionsResponse
+	(*SubscribeRequest)(nil),          // 3: v1.SubscribeRequest
+	(*SubscribeResponse)(nil),         // 4: v1.SubscribeResponse
+	(*UnsubscribeRequest)(nil),        // 5: v1.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),       // 6: v1.UnsubscribeResponse
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+}
+var file_proto_v1_subscription_subscription_proto_depIdxs = []int32{
+	7, // 0: v1.SubscriptionData.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: v1.ListSubscriptionsResponse.subscriptions:type_name -> v1.SubscriptionData
+	0, // 2: v1.SubscribeResponse.subscription:type_name -> v1.SubscriptionData
+	1, // 3: v1.SubscriptionService.ListSubscriptions:input_type -> v1.ListSubscriptionsRequest
+	3, // 4: v1.SubscriptionService.Subscribe:input_type -> v1.SubscribeRequest
+	5, // 5: v1.SubscriptionService.Unsubscribe:input_type -> v1.UnsubscribeRequest
+	2, // 6: v1.SubscriptionService.ListSubscriptions:output_type -> v1.ListSubscriptionsResponse
+	4, // 7: v1.SubscriptionService.Subscribe:output_type -> v1.SubscribeResponse
+	6, // 8: v1.SubscriptionService.Unsubscribe:output_type -> v1.UnsubscribeResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_subscription_subscription_proto_init() }
+func file_proto_v1_subscription_subscription_proto_init() {
+	if File_proto_v1_subscription_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_subscription_subscription_proto_rawDesc), len(file_proto_v1_subscription_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_subscription_subscription_proto_goTypes,
+		DependencyIndexes: file_proto_v1_subscription_subscription_proto_depIdxs,
+		MessageInfos:      file_proto_v1_subscription_subscription_proto_msgTypes,
+	}.Build()
+	File_proto_v1_subscription_subscription_proto = out.File
+	file_proto_v1_subscription_subscription_proto_goTypes = nil
+	file_proto_v1_subscription_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.32.0
+// source: proto/v1/wishlist/wishlist.proto
+
+package wishlistv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	cart "toptal/proto/v1/cart"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WishlistItemData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	InStock       bool                   `protobuf:"varint,4,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItemData) Reset() {
+	*x = WishlistItemData{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItemData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItemData) ProtoMessage() {}
+
+func (x *WishlistItemData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItemData.ProtoReflect.Descriptor instead.
+func (*WishlistItemData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WishlistItemData) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *WishlistItemData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WishlistItemData) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *WishlistItemData) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *WishlistItemData) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type WishlistData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*WishlistItemData    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistData) Reset() {
+	*x = WishlistData{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistData) ProtoMessage() {}
+
+func (x *WishlistData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistData.ProtoReflect.Descriptor instead.
+func (*WishlistData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WishlistData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistData) GetItems() []*WishlistItemData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *WishlistData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WishlistData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Wishlist      *WishlistData          `protobuf:"bytes,2,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistResponse) Reset() {
+	*x = WishlistResponse{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistResponse) ProtoMessage() {}
+
+func (x *WishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistResponse.ProtoReflect.Descriptor instead.
+func (*WishlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WishlistResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WishlistResponse) GetWishlist() *WishlistData {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type ListWishlistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsRequest) Reset() {
+	*x = ListWishlistsRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsRequest) ProtoMessage() {}
+
+func (x *ListWishlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{3}
+}
+
+type ListWishlistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlists     []*WishlistResponse    `protobuf:"bytes,1,rep,name=wishlists,proto3" json:"wishlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsResponse) Reset() {
+	*x = ListWishlistsResponse{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsResponse) ProtoMessage() {}
+
+func (x *ListWishlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWishlistsResponse) GetWishlists() []*WishlistResponse {
+	if x != nil {
+		return x.Wishlists
+	}
+	return nil
+}
+
+type CreateWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistRequest) Reset() {
+	*x = CreateWishlistRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistRequest) ProtoMessage() {}
+
+func (x *CreateWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWishlistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RenameWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWishlistRequest) Reset() {
+	*x = RenameWishlistRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWishlistRequest) ProtoMessage() {}
+
+func (x *RenameWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RenameWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RenameWishlistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistRequest) Reset() {
+	*x = DeleteWishlistRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistRequest) ProtoMessage() {}
+
+func (x *DeleteWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteWishlistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistResponse) Reset() {
+	*x = DeleteWishlistResponse{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistResponse) ProtoMessage() {}
+
+func (x *DeleteWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId        int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWishlistItemRequest) Reset() {
+	*x = AddWishlistItemRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWishlistItemRequest) ProtoMessage() {}
+
+func (x *AddWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddWishlistItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddWishlistItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type RemoveWishlistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId        int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistItemRequest) Reset() {
+	*x = RemoveWishlistItemRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistItemRequest) ProtoMessage() {}
+
+func (x *RemoveWishlistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveWishlistItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveWishlistItemRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type MoveWishlistItemToCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId        int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistItemToCartRequest) Reset() {
+	*x = MoveWishlistItemToCartRequest{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistItemToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistItemToCartRequest) ProtoMessage() {}
+
+func (x *MoveWishlistItemToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistItemToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveWishlistItemToCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveWishlistItemToCartRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveWishlistItemToCartRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+type MoveWishlistItemToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlist      *WishlistResponse      `protobuf:"bytes,1,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	Cart          *cart.CartData         `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	CartItems     []*cart.CartItemData   `protobuf:"bytes,3,rep,name=cart_items,json=cartItems,proto3" json:"cart_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistItemToCartResponse) Reset() {
+	*x = MoveWishlistItemToCartResponse{}
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistItemToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistItemToCartResponse) ProtoMessage() {}
+
+func (x *MoveWishlistItemToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_wishlist_wishlist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistItemToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveWishlistItemToCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_wishlist_wishlist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveWishlistItemToCartResponse) GetWishlist() *WishlistResponse {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+func (x *MoveWishlistItemToCartResponse) GetCart() *cart.CartData {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *MoveWishlistItemToCartResponse) GetCartItems() []*cart.CartItemData {
+	if x != nil {
+		return x.CartItems
+	}
+	return nil
+}
+
+var File_proto_v1_wishlist_wishlist_proto protoreflect.FileDescriptor
+
+const file_proto_v1_wishlist_wishlist_proto_rawDesc = "" +
+	"\n" +
+	" proto/v1/wishlist/wishlist.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18proto/v1/cart/cart.proto\"\xa9\x01\n" +
+	"\x10WishlistItemData\x12\x17\n" +
+	"\abook_id\x18\x01 \x01(\x03R\x06bookId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x19\n" +
+	"\bin_stock\x18\x04 \x01(\bR\ainStock\x125\n" +
+	"\badded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"\xc4\x01\n" +
+	"\fWishlistData\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.v1.WishlistItemDataR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	"\x10WishlistResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\bwishlist\x18\x02 \x01(\v2\x10.v1.WishlistDataR\bwishlist\"\x16\n" +
+	"\x14ListWishlistsRequest\"K\n" +
+	"\x15ListWishlistsResponse\x122\n" +
+	"\twishlists\x18\x01 \x03(\v2\x14.v1.WishlistResponseR\twishlists\"+\n" +
+	"\x15CreateWishlistRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"$\n" +
+	"\x12GetWishlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\";\n" +
+	"\x15RenameWishlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"'\n" +
+	"\x15DeleteWishlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16DeleteWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x16AddWishlistItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\x03R\x06bookId\"D\n" +
+	"\x19RemoveWishlistItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\x03R\x06bookId\"H\n" +
+	"\x1dMoveWishlistItemToCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\abook_id\x18\x02 \x01(\x03R\x06bookId\"\xa5\x01\n" +
+	"\x1eMoveWishlistItemToCartResponse\x120\n" +
+	"\bwishlist\x18\x01 \x01(\v2\x14.v1.WishlistResponseR\bwishlist\x12 \n" +
+	"\x04cart\x18\x02 \x01(\v2\f.v1.CartDataR\x04cart\x12/\n" +
+	"\n" +
+	"cart_items\x18\x03 \x03(\v2\x10.v1.CartItemDataR\tcartItems2\xf1\x06\n" +
+	"\x0fWishlistService\x12[\n" +
+	"\rListWishlists\x12\x18.v1.ListWishlistsRequest\x1a\x19.v1.ListWishlistsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/wishlists\x12[\n" +
+	"\x0eCreateWishlist\x12\x19.v1.CreateWishlistRequest\x1a\x14.v1.WishlistResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/wishlists\x12W\n" +
+	"\vGetWishlist\x12\x16.v1.GetWishlistRequest\x1a\x14.v1.WishlistResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/wishlists/{id}\x12`\n" +
+	"\x0eRenameWishlist\x12\x19.v1.RenameWishlistRequest\x1a\x14.v1.WishlistResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/wishlists/{id}\x12c\n" +
+	"\x0eDeleteWishlist\x12\x19.v1.DeleteWishlistRequest\x1a\x1a.v1.DeleteWishlistResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/wishlists/{id}\x12o\n" +
+	"\x0fAddWishlistItem\x12\x1a.v1.AddWishlistItemRequest\x1a\x14.v1.WishlistResponse\"*\x82\xd3\xe4\x93\x02$\x1a\"/v1/wishlists/{id}/items/{book_id}\x12u\n" +
+	"\x12RemoveWishlistItem\x12\x1d.v1.RemoveWishlistItemRequest\x1a\x14.v1.WishlistResponse\"*\x82\xd3\xe4\x93\x02$*\"/v1/wishlists/{id}/items/{book_id}\x12\x9b\x01\n" +
+	"\x16MoveWishlistItemToCart\x12!.v1.MoveWishlistItemToCartRequest\x1a\".v1.MoveWishlistItemToCartResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/wishlists/{id}/items/{book_id}/move-to-cartB&Z$toptal/proto/v1/wishlist; wishlistv1b\x06proto3"
+
+var (
+	file_proto_v1_wishlist_wishlist_proto_rawDescOnce sync.Once
+	file_proto_v1_wishlist_wishlist_proto_rawDescData []byte
+)
+
+func file_proto_v1_wishlist_wishlist_proto_rawDescGZIP() []byte {
+	file_proto_v1_wishlist_wishlist_proto_rawDescOnce.Do(func() {
+		file_proto_v1_wishlist_wishlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_wishlist_wishlist_proto_rawDesc), len(file_proto_v1_wishlist_wishlist_proto_rawDesc)))
+	})
+	return file_proto_v1_wishlist_wishlist_proto_rawDescData
+}
+
+var file_proto_v1_wishlist_wishlist_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_v1_wishlist_wishlist_proto_goTypes = []any{
+	(*WishlistItemData)(nil),               // 0: v1.WishlistItemData
+	(*WishlistData)(nil),                   // 1: v1.WishlistData
+	(*WishlistResponse)(nil),               // 2: v1.WishlistResponse
+	(*ListWishlistsRequest)(nil),           // 3: v1.ListWishlistsRequest
+	(*ListWishlistsResponse)(nil),          // 4: v1.ListWishlistsResponse
+	(*CreateWishlistRequest)(nil),          // 5: v1.CreateWishlistRequest
+	(*GetWishlistRequest)(nil),             // 6: v1.GetWishlistRequest
+	(*RenameWishlistRequest)(nil),          // 7: v1.RenameWishlistRequest
+	(*DeleteWishlistRequest)(nil),          // 8: v1.DeleteWishlistRequest
+	(*DeleteWishlistResponse)(nil),         // 9: v1.DeleteWishlistResponse
+	(*AddWishlistItemRequest)(nil),         // 10: v1.AddWishlistItemRequest
+	(*RemoveWishlistItemRequest)(nil),      // 11: v1.RemoveWishlistItemRequest
+	(*MoveWishlistItemToCartRequest)(nil),  // 12: v1.MoveWishlistItemToCartRequest
+	(*MoveWishlistItemToCartResponse)(nil), // 13: v1.MoveWishlistItemToCartResponse
+	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
+	(*cart.CartData)(nil),                  // 15: v1.CartData
+	(*cart.CartItemData)(nil),              // 16: v1.CartItemData
+}
+var file_proto_v1_wishlist_wishlist_proto_depIdxs = []int32{
+	14, // 0: v1.WishlistItemData.added_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: v1.WishlistData.items:type_name -> v1.WishlistItemData
+	14, // 2: v1.WishlistData.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: v1.WishlistData.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: v1.WishlistResponse.wishlist:type_name -> v1.WishlistData
+	2,  // 5: v1.ListWishlistsResponse.wishlists:type_name -> v1.WishlistResponse
+	2,  // 6: v1.MoveWishlistItemToCartResponse.wishlist:type_name -> v1.WishlistResponse
+	15, // 7: v1.MoveWishlistItemToCartResponse.cart:type_name -> v1.CartData
+	16, // 8: v1.MoveWishlistItemToCartResponse.cart_items:type_name -> v1.CartItemData
+	3,  // 9: v1.WishlistService.ListWishlists:input_type -> v1.ListWishlistsRequest
+	5,  // 10: v1.WishlistService.CreateWishlist:input_type -> v1.CreateWishlistRequest
+	6,  // 11: v1.WishlistService.GetWishlist:input_type -> v1.GetWishlistRequest
+	7,  // 12: v1.WishlistService.RenameWishlist:input_type -> v1.RenameWishlistRequest
+	8,  // 13: v1.WishlistService.DeleteWishlist:input_type -> v1.DeleteWishlistRequest
+	10, // 14: v1.WishlistService.AddWishlistItem:input_type -> v1.AddWishlistItemRequest
+	11, // 15: v1.WishlistService.RemoveWishlistItem:input_type -> v1.RemoveWishlistItemRequest
+	12, // 16: v1.WishlistService.MoveWishlistItemToCart:input_type -> v1.MoveWishlistItemToCartRequest
+	4,  // 17: v1.WishlistService.ListWishlists:output_type -> v1.ListWishlistsResponse
+	2,  // 18: v1.WishlistService.CreateWishlist:output_type -> v1.WishlistResponse
+	2,  // 19: v1.WishlistService.GetWishlist:output_type -> v1.WishlistResponse
+	2,  // 20: v1.WishlistService.RenameWishlist:output_type -> v1.WishlistResponse
+	9,  // 21: v1.WishlistService.DeleteWishlist:output_type -> v1.DeleteWishlistResponse
+	2,  // 22: v1.WishlistService.AddWishlistItem:output_type -> v1.WishlistResponse
+	2,  // 23: v1.WishlistService.RemoveWishlistItem:output_type -> v1.WishlistResponse
+	13, // 24: v1.WishlistService.MoveWishlistItemToCart:output_type -> v1.MoveWishlistItemToCartResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_wishlist_wishlist_proto_init() }
+func file_proto_v1_wishlist_wishlist_proto_init() {
+	if File_proto_v1_wishlist_wishlist_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_wishlist_wishlist_proto_rawDesc), len(file_proto_v1_wishlist_wishlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_wishlist_wishlist_proto_goTypes,
+		DependencyIndexes: file_proto_v1_wishlist_wishlist_proto_depIdxs,
+		MessageInfos:      file_proto_v1_wishlist_wishlist_proto_msgTypes,
+	}.Build()
+	File_proto_v1_wishlist_wishlist_proto = out.File
+	file_proto_v1_wishlist_wishlist_proto_goTypes = nil
+	file_proto_v1_wishlist_wishlist_proto_depIdxs = nil
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: proto/v1/wishlist/wishlist.proto
+
+package wishlistv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WishlistService_ListWishlists_FullMethodName          = "/v1.WishlistService/ListWishlists"
+	WishlistService_CreateWishlist_FullMethodName         = "/v1.WishlistService/CreateWishlist"
+	WishlistService_GetWishlist_FullMethodName            = "/v1.WishlistService/GetWishlist"
+	WishlistService_RenameWishlist_FullMethodName         = "/v1.WishlistService/RenameWishlist"
+	WishlistService_DeleteWishlist_FullMethodName         = "/v1.WishlistService/DeleteWishlist"
+	WishlistService_AddWishlistItem_FullMethodName        = "/v1.WishlistService/AddWishlistItem"
+	WishlistService_RemoveWishlistItem_FullMethodName     = "/v1.WishlistService/RemoveWishlistItem"
+	WishlistService_MoveWishlistItemToCart_FullMethodName = "/v1.WishlistService/MoveWishlistItemToCart"
+)
+
+// WishlistServiceClient is the client API for WishlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WishlistServiceClient interface {
+	ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error)
+	CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error)
+	AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error)
+}
+
+type wishlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWishlistServiceClient(cc grpc.ClientConnInterface) WishlistServiceClient {
+	return &wishlistServiceClient{cc}
+}
+
+func (c *wishlistServiceClient) ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistsResponse)
+	err := c.cc.Invoke(ctx, WishlistService_ListWishlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_CreateWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_RenameWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_DeleteWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_AddWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, WishlistService_RemoveWishlistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wishlistServiceClient) MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveWishlistItemToCartResponse)
+	err := c.cc.Invoke(ctx, WishlistService_MoveWishlistItemToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WishlistServiceServer is the server API for WishlistService service.
+// All implementations must embed UnimplementedWishlistServiceServer
+// for forward compatibility.
+type WishlistServiceServer interface {
+	ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error)
+	CreateWishlist(context.Context, *CreateWishlistRequest) (*WishlistResponse, error)
+	GetWishlist(context.Context, *GetWishlistRequest) (*WishlistResponse, error)
+	RenameWishlist(context.Context, *RenameWishlistRequest) (*WishlistResponse, error)
+	DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error)
+	AddWishlistItem(context.Context, *AddWishlistItemRequest) (*WishlistResponse, error)
+	RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*WishlistResponse, error)
+	MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error)
+	mustEmbedUnimplementedWishlistServiceServer()
+}
+
+// UnimplementedWishlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWishlistServiceServer struct{}
+
+func (UnimplementedWishlistServiceServer) ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlists not implemented")
+}
+func (UnimplementedWishlistServiceServer) CreateWishlist(context.Context, *CreateWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) GetWishlist(context.Context, *GetWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) RenameWishlist(context.Context, *RenameWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWishlist not implemented")
+}
+func (UnimplementedWishlistServiceServer) AddWishlistItem(context.Context, *AddWishlistItemRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlistItem not implemented")
+}
+func (UnimplementedWishlistServiceServer) RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItem not implemented")
+}
+func (UnimplementedWishlistServiceServer) MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWishlistItemToCart not implemented")
+}
+func (UnimplementedWishlistServiceServer) mustEmbedUnimplementedWishlistServiceServer() {}
+func (UnimplementedWishlistServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWishlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WishlistServiceServer will
+// result in compilation errors.
+type UnsafeWishlistServiceServer interface {
+	mustEmbedUnimplementedWishlistServiceServer()
+}
+
+func RegisterWishlistServiceServer(s grpc.ServiceRegistrar, srv WishlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWishlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WishlistService_ServiceDesc, srv)
+}
+
+func _WishlistService_ListWishlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).ListWishlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_ListWishlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).ListWishlists(ctx, req.(*ListWishlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).CreateWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_CreateWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).CreateWishlist(ctx, req.(*CreateWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).GetWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_GetWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).GetWishlist(ctx, req.(*GetWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_RenameWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).RenameWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_RenameWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).RenameWishlist(ctx, req.(*RenameWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_DeleteWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).DeleteWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_DeleteWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).DeleteWishlist(ctx, req.(*DeleteWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_AddWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).AddWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_AddWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).AddWishlistItem(ctx, req.(*AddWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_RemoveWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).RemoveWishlistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_RemoveWishlistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).RemoveWishlistItem(ctx, req.(*RemoveWishlistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WishlistService_MoveWishlistItemToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveWishlistItemToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WishlistServiceServer).MoveWishlistItemToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WishlistService_MoveWishlistItemToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WishlistServiceServer).MoveWishlistItemToCart(ctx, req.(*MoveWishlistItemToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WishlistService_ServiceDesc is the grpc.ServiceDesc for WishlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WishlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.WishlistService",
+	HandlerType: (*WishlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWishlists",
+			Handler:    _WishlistService_ListWishlists_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _WishlistService_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _WishlistService_GetWishlist_Handler,
+		},
+		{
+			MethodName: "RenameWishlist",
+			Handler:    _WishlistService_RenameWishlist_Handler,
+		},
+		{
+			MethodName: "DeleteWishlist",
+			Handler:    _WishlistService_DeleteWishlist_Handler,
+		},
+		{
+			MethodName: "AddWishlistItem",
+			Handler:    _WishlistService_AddWishlistItem_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItem",
+			Handler:    _WishlistService_RemoveWishlistItem_Handler,
+		},
+		{
+			MethodName: "MoveWishlistItemToCart",
+			Handler:    _WishlistService_MoveWishlistItemToCart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/wishlist/wishlist.proto",
+}